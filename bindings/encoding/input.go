@@ -252,6 +252,15 @@ var (
 		{Name: "assignment.maxProposedIn", Type: uint64Type},
 		{Name: "assignment.tierFees", Type: tierFeesType},
 	}
+	proverQuotePayloadArgs = abi.Arguments{
+		{Name: "PROVER_QUOTE", Type: stringType},
+		{Name: "chainID", Type: uint64Type},
+		{Name: "taikoAddress", Type: addressType},
+		{Name: "prover", Type: addressType},
+		{Name: "quote.feeToken", Type: addressType},
+		{Name: "quote.expiry", Type: uint64Type},
+		{Name: "quote.tierFees", Type: tierFeesType},
+	}
 	blockMetadataComponentsType, _ = abi.NewType("tuple", "TaikoData.BlockMetadata", blockMetadataComponents)
 	transitionComponentsType, _    = abi.NewType("tuple", "TaikoData.Transition", transitionComponents)
 	tierProofComponentsType, _     = abi.NewType("tuple", "TaikoData.TierProof", tierProofComponents)
@@ -347,6 +356,22 @@ func EncodeProverAssignmentPayload(
 	return b, nil
 }
 
+// EncodeProverQuotePayload performs the solidity `abi.encode` for the given prover quote payload.
+func EncodeProverQuotePayload(
+	chainID uint64,
+	taikoAddress common.Address,
+	prover common.Address,
+	feeToken common.Address,
+	expiry uint64,
+	tierFees []TierFee,
+) ([]byte, error) {
+	b, err := proverQuotePayloadArgs.Pack("PROVER_QUOTE", chainID, taikoAddress, prover, feeToken, expiry, tierFees)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.encode prover quote payload, %w", err)
+	}
+	return b, nil
+}
+
 // EncodeProveBlockInput performs the solidity `abi.encode` for the given TaikoL1.proveBlock input.
 func EncodeProveBlockInput(
 	meta *bindings.TaikoDataBlockMetadata,
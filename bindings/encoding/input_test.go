@@ -33,6 +33,20 @@ func TestEncodeProverAssignmentPayload(t *testing.T) {
 	require.NotNil(t, encoded)
 }
 
+func TestEncodeProverQuotePayload(t *testing.T) {
+	encoded, err := EncodeProverQuotePayload(
+		randomHash().Big().Uint64(),
+		common.BytesToAddress(randomBytes(20)),
+		common.BytesToAddress(randomBytes(20)),
+		common.BytesToAddress(randomBytes(20)),
+		120,
+		[]TierFee{{Tier: 0, Fee: common.Big1}},
+	)
+
+	require.Nil(t, err)
+	require.NotNil(t, encoded)
+}
+
 func TestEncodeAssignmentHookInput(t *testing.T) {
 	encoded, err := EncodeAssignmentHookInput(&AssignmentHookInput{
 		Assignment: &ProverAssignment{
@@ -114,8 +114,45 @@ var (
 		Category: commonCategory,
 		Value:    1 * time.Minute,
 	}
+	// Used by both proposer and driver, they must use the same codec.
+	TxListCodec = &cli.StringFlag{
+		Name:     "txList.codec",
+		Usage:    "Codec used to compress the proposed transactions lists, available codecs: none, zlib, brotli",
+		Category: commonCategory,
+		Value:    "none",
+	}
+	// Used by both proposer and prover, alternatives to the raw private key flags.
+	SignerKeystore = &cli.StringFlag{
+		Name:     "signer.keystore",
+		Usage:    "Path of the encrypted JSON keystore file of the L1 account, instead of a raw private key",
+		Category: commonCategory,
+	}
+	SignerKeystorePassword = &cli.StringFlag{
+		Name:     "signer.keystorePassword",
+		Usage:    "Path of the file containing the password of --signer.keystore",
+		Category: commonCategory,
+	}
+	SignerRemoteURL = &cli.StringFlag{
+		Name: "signer.remote",
+		Usage: "HTTP endpoint of a Web3Signer or Clef compatible remote signer, instead of a raw private key, " +
+			"Clef is only supported by the proposer, since it can't sign the prover assignments",
+		Category: commonCategory,
+	}
+	SignerRemoteAddress = &cli.StringFlag{
+		Name:     "signer.remoteAddress",
+		Usage:    "Address of the L1 account signed by --signer.remote",
+		Category: commonCategory,
+	}
 )
 
+// SignerFlags All signer flags.
+var SignerFlags = []cli.Flag{
+	SignerKeystore,
+	SignerKeystorePassword,
+	SignerRemoteURL,
+	SignerRemoteAddress,
+}
+
 // CommonFlags All common flags.
 var CommonFlags = []cli.Flag{
 	// Required
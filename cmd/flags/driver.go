@@ -43,6 +43,18 @@ var (
 		Usage:    "HTTP RPC endpoint of another synced L2 execution engine node",
 		Category: driverCategory,
 	}
+	// Blob related.
+	L1BeaconEndpoint = &cli.StringFlag{
+		Name:     "l1.beacon",
+		Usage:    "HTTP endpoint of a L1 beacon node, used to fetch the blobs of blob-backed proposals",
+		Category: driverCategory,
+	}
+	BlobLocalSource = &cli.StringFlag{
+		Name: "blob.localSource",
+		Usage: "Local directory path or HTTP endpoint serving hex encoded blobs named by their versioned hashes, " +
+			"only used for testing purposes when no L1 beacon node is available",
+		Category: driverCategory,
+	}
 )
 
 // DriverFlags All driver flags.
@@ -53,4 +65,7 @@ var DriverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	P2PSyncVerifiedBlocks,
 	P2PSyncTimeout,
 	CheckPointSyncURL,
+	L1BeaconEndpoint,
+	BlobLocalSource,
+	TxListCodec,
 })
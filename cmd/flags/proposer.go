@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/taikoxyz/taiko-client/version"
 	"github.com/urfave/cli/v2"
 )
@@ -8,15 +10,15 @@ import (
 // Required flags used by proposer.
 var (
 	L1ProposerPrivKey = &cli.StringFlag{
-		Name:     "l1.proposerPrivKey",
-		Usage:    "Private key of the L1 proposer, who will send TaikoL1.proposeBlock transactions",
-		Required: true,
+		Name: "l1.proposerPrivKey",
+		Usage: "Private key of the L1 proposer, who will send TaikoL1.proposeBlock transactions, " +
+			"or use --signer.keystore / --signer.remote instead",
 		Category: proposerCategory,
 	}
 	ProverEndpoints = &cli.StringFlag{
-		Name:     "proverEndpoints",
-		Usage:    "Comma-delineated list of prover endpoints proposer should query when attempting to propose a block",
-		Required: true,
+		Name: "proverEndpoints",
+		Usage: "Comma-delineated list of prover endpoints proposer should query when attempting to propose a block, " +
+			"or use --proverRegistry.url / --proverRegistry.file to discover them dynamically",
 		Category: proposerCategory,
 	}
 	ProposerAssignmentHookAddress = &cli.StringFlag{
@@ -62,6 +64,147 @@ var (
 		Category: proposerCategory,
 		Value:    3,
 	}
+	// Fee token related.
+	FeeToken = &cli.StringFlag{
+		Name:     "feeToken.address",
+		Usage:    "ERC-20 token to pay the prover fees, the tier fees are still configured in wei, ETH is used if not set",
+		Category: proposerCategory,
+	}
+	FeeTokenPrices = &cli.StringFlag{
+		Name: "feeToken.prices",
+		Usage: "Comma-delineated list of `token:tokenPerETH` prices, used to convert the tier fees, " +
+			"tokenPerETH is the amount of the token's base units which equals to 1 ETH",
+		Category: proposerCategory,
+	}
+	FeeTokenAllowance = &cli.StringFlag{
+		Name: "feeToken.allowance",
+		Usage: "Amount of the fee token to approve AssignmentHook contract for the prover fees, the allowance " +
+			"is topped up to this amount once it can't cover the prover fees of a proposing epoch",
+		Category: proposerCategory,
+	}
+	// Prover auction related.
+	ProverAuction = &cli.BoolFlag{
+		Name: "auction.enabled",
+		Usage: "Send the prover assignment requests to all prover endpoints at once in each tier fee round, " +
+			"at their quoted tier fees if cheaper, and pick the cheapest valid response within the deadline",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	ProverAuctionDeadline = &cli.DurationFlag{
+		Name:     "auction.deadline",
+		Usage:    "Deadline to collect the prover assignment responses in each auction round",
+		Value:    3 * time.Second,
+		Category: proposerCategory,
+	}
+	// Dry-run related.
+	DryRun = &cli.BoolFlag{
+		Name: "dryRun.enabled",
+		Usage: "Run the full proposing pipeline without sending the proposeBlock transactions, " +
+			"the would-be proposals are recorded to logs and metrics instead. Note that prover assignments " +
+			"are still requested, which hold the provers' capacity until they expire",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	DryRunFile = &cli.StringFlag{
+		Name:     "dryRun.file",
+		Usage:    "Path of a JSONL file to append the would-be proposals to in dry-run mode",
+		Category: proposerCategory,
+	}
+	// Prover discovery related.
+	ProverRegistryURL = &cli.StringFlag{
+		Name:     "proverRegistry.url",
+		Usage:    "URL of a prover registry which returns a JSON list of prover endpoints metadata",
+		Category: proposerCategory,
+	}
+	ProverRegistryFile = &cli.StringFlag{
+		Name:     "proverRegistry.file",
+		Usage:    "Path of a file containing a JSON list of prover endpoints metadata, reloaded on change",
+		Category: proposerCategory,
+	}
+	ProverRegistryInterval = &cli.DurationFlag{
+		Name:     "proverRegistry.interval",
+		Usage:    "Interval to refresh and health check the discovered prover endpoints",
+		Value:    1 * time.Minute,
+		Category: proposerCategory,
+	}
+	// Forced inclusion related.
+	ForcedInclusionURL = &cli.StringFlag{
+		Name:     "forcedInclusion.url",
+		Usage:    "URL of a forced inclusion queue, which returns a JSON list of hex encoded signed L2 transactions",
+		Category: proposerCategory,
+	}
+	ForcedInclusionFile = &cli.StringFlag{
+		Name:     "forcedInclusion.file",
+		Usage:    "Path of a file containing a JSON list of hex encoded signed L2 transactions to include forcibly",
+		Category: proposerCategory,
+	}
+	ForcedInclusionResubmitTimeout = &cli.DurationFlag{
+		Name:     "forcedInclusion.resubmitTimeout",
+		Usage:    "Timeout to propose a forced transaction again, if it is not found in L2 chain after proposed",
+		Value:    5 * time.Minute,
+		Category: proposerCategory,
+	}
+	ForcedInclusionMaxResubmissions = &cli.Uint64Flag{
+		Name:     "forcedInclusion.maxResubmissions",
+		Usage:    "Maximum times to propose a forced transaction again, before dropping it",
+		Value:    3,
+		Category: proposerCategory,
+	}
+	ForcedInclusionRetention = &cli.DurationFlag{
+		Name: "forcedInclusion.retention",
+		Usage: "Duration to remember the included and dropped forced transactions, " +
+			"so that they won't be proposed again while still in the queue",
+		Value:    24 * time.Hour,
+		Category: proposerCategory,
+	}
+	// Leader election related.
+	LeaderElectionFile = &cli.StringFlag{
+		Name: "leaderElection.file",
+		Usage: "Path of the leader lease file on a shared storage, if set, only the leader among the redundant " +
+			"proposer instances with the same proposer account proposes",
+		Category: proposerCategory,
+	}
+	LeaderElectionID = &cli.StringFlag{
+		Name:     "leaderElection.id",
+		Usage:    "Unique ID of this proposer instance in leader election, default to hostname and process ID",
+		Category: proposerCategory,
+	}
+	LeaderLeaseTTL = &cli.DurationFlag{
+		Name:     "leaderElection.leaseTTL",
+		Usage:    "TTL of the leader lease, a standby takes over when the leader fails to renew it in time",
+		Value:    30 * time.Second,
+		Category: proposerCategory,
+	}
+	// Prover reputation related.
+	ProverReputationFile = &cli.StringFlag{
+		Name:     "proverReputation.file",
+		Usage:    "Path of the file to persist the prover endpoints reputation, empty means keeping it in memory only",
+		Category: proposerCategory,
+	}
+	ProverCooldownThreshold = &cli.Uint64Flag{
+		Name:     "proverReputation.cooldownThreshold",
+		Usage:    "Number of consecutive HTTP failures before a prover endpoint is cooled down, 0 disables the cool-down",
+		Value:    3,
+		Category: proposerCategory,
+	}
+	ProverCooldown = &cli.DurationFlag{
+		Name:     "proverReputation.cooldown",
+		Usage:    "Duration of a prover endpoint cool-down",
+		Value:    1 * time.Minute,
+		Category: proposerCategory,
+	}
+	ProverBlacklistScore = &cli.Float64Flag{
+		Name:     "proverReputation.blacklistScore",
+		Usage:    "Negative reputation score under which a prover endpoint is blacklisted",
+		Value:    -10,
+		Category: proposerCategory,
+	}
+	ProverBlacklistDuration = &cli.DurationFlag{
+		Name:     "proverReputation.blacklistDuration",
+		Usage:    "Duration of a prover endpoint blacklist, 0 disables the blacklist",
+		Value:    1 * time.Hour,
+		Category: proposerCategory,
+	}
 	// Proposing epoch related.
 	ProposeInterval = &cli.DurationFlag{
 		Name:     "epoch.interval",
@@ -73,6 +216,32 @@ var (
 		Usage:    "Time interval to propose empty blocks",
 		Category: proposerCategory,
 	}
+	AdaptiveProposeInterval = &cli.BoolFlag{
+		Name: "epoch.adaptive",
+		Usage: "Adjust the proposing interval between --epoch.minInterval and --epoch.maxInterval, " +
+			"based on the transaction pool pressure and L1 base fee, --epoch.interval is used as the initial interval",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	MinProposeInterval = &cli.DurationFlag{
+		Name:     "epoch.minInterval",
+		Usage:    "Minimum proposing interval when --epoch.adaptive is enabled",
+		Value:    12 * time.Second,
+		Category: proposerCategory,
+	}
+	MaxProposeInterval = &cli.DurationFlag{
+		Name:     "epoch.maxInterval",
+		Usage:    "Maximum proposing interval when --epoch.adaptive is enabled",
+		Value:    120 * time.Second,
+		Category: proposerCategory,
+	}
+	L1BaseFeeSpikePercentage = &cli.Uint64Flag{
+		Name: "epoch.l1BaseFeeSpikePercentage",
+		Usage: "L1 base fee is seen as spiking when it is above this percentage of its moving average, " +
+			"used when --epoch.adaptive is enabled",
+		Value:    150,
+		Category: proposerCategory,
+	}
 	// Proposing metadata related.
 	ExtraData = &cli.StringFlag{
 		Name:     "extraData",
@@ -92,6 +261,39 @@ var (
 		Value:    false,
 		Category: proposerCategory,
 	}
+	TxPoolAllowedSenders = &cli.StringFlag{
+		Name:     "txpool.allowedSenders",
+		Usage:    "Comma separated accounts, if set, proposer will only propose transactions from these accounts",
+		Category: proposerCategory,
+	}
+	TxPoolDeniedSenders = &cli.StringFlag{
+		Name:     "txpool.deniedSenders",
+		Usage:    "Comma separated accounts, proposer will never propose transactions from these accounts",
+		Category: proposerCategory,
+	}
+	TxPoolDeniedContracts = &cli.StringFlag{
+		Name:     "txpool.deniedContracts",
+		Usage:    "Comma separated contract addresses, proposer will never propose transactions calling them",
+		Category: proposerCategory,
+	}
+	TxPoolMaxTxsPerSender = &cli.Uint64Flag{
+		Name:     "txpool.maxTxsPerSender",
+		Usage:    "Maximum number of transactions from one account proposed inside one proposing epoch, 0 for unlimited",
+		Value:    0,
+		Category: proposerCategory,
+	}
+	TxPoolMinTip = &cli.Uint64Flag{
+		Name:     "txpool.minTip",
+		Usage:    "Minimum effective gas tip (in wei) of a proposed transaction at the current L2 base fee",
+		Value:    0,
+		Category: proposerCategory,
+	}
+	TxPoolFeePriority = &cli.BoolFlag{
+		Name:     "txpool.feePriority",
+		Usage:    "Reorder the transactions in each transactions list by effective gas tips, keeping nonce order",
+		Value:    false,
+		Category: proposerCategory,
+	}
 	MaxProposedTxListsPerEpoch = &cli.Uint64Flag{
 		Name:     "txpool.maxTxListsPerEpoch",
 		Usage:    "Maximum number of transaction lists which will be proposed inside one proposing epoch",
@@ -115,28 +317,107 @@ var (
 		Usage:    "Gas tip cap (in wei) for a TaikoL1.proposeBlock transaction when doing the transaction replacement",
 		Category: proposerCategory,
 	}
+	StuckTxTimeout = &cli.DurationFlag{
+		Name:     "tx.stuckTimeout",
+		Usage:    "Timeout when a pending proposer transaction is considered stuck and will be replaced",
+		Value:    3 * time.Minute,
+		Category: proposerCategory,
+	}
 	ProposeBlockIncludeParentMetaHash = &cli.BoolFlag{
 		Name:     "includeParentMetaHash",
 		Usage:    "Include parent meta hash when proposing block",
 		Value:    false,
 		Category: proposerCategory,
 	}
+	// Blob related.
+	BlobAllowed = &cli.BoolFlag{
+		Name:     "l1.blobAllowed",
+		Usage:    "Send EIP-4844 blob transactions when proposing blocks",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	CacheBlobForReuse = &cli.BoolFlag{
+		Name:     "l1.cacheBlobForReuse",
+		Usage:    "Ask the protocol to cache the proposed blob for reuse, only works with --l1.blobAllowed",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	PackTxListsIntoBlob = &cli.BoolFlag{
+		Name: "l1.blobPackTxLists",
+		Usage: "Pack all transactions lists of a proposing epoch into shared blobs, later proposals will reuse " +
+			"the blob cached by the first one, only works with --l1.blobAllowed",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	// Hook related.
+	HookCalls = &cli.StringFlag{
+		Name: "hooks.calls",
+		Usage: "Comma-delineated list of additional hook calls attached to every proposal, in " +
+			"`<hook address>:<hex ABI-encoded data template>` format, {{.Proposer}}, {{.AssignedProver}} and " +
+			"{{.Tip}} placeholders in the template will be replaced by the proposal's ABI-encoded values",
+		Category: proposerCategory,
+	}
+	FixedTip = &cli.Uint64Flag{
+		Name:     "tip.fixed",
+		Usage:    "Fixed tip (in wei) paid to the L1 block builder through the AssignmentHook for every proposal",
+		Value:    0,
+		Category: proposerCategory,
+	}
+	TipPriorityFeePercentage = &cli.Uint64Flag{
+		Name: "tip.priorityFeePercentage",
+		Usage: "Percentage of the proposed transactions list's L2 priority fees additionally paid as tip to " +
+			"the L1 block builder",
+		Value:    0,
+		Category: proposerCategory,
+	}
+	// Profitability related.
+	ProfitabilityCheck = &cli.BoolFlag{
+		Name: "profitability.check",
+		Usage: "Only propose a transactions list when its estimated L2 fee revenue covers the L1 proposing cost " +
+			"plus the prover fee, unprofitable transactions lists will be merged or deferred",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	L2BaseFeeSharePercentage = &cli.Uint64Flag{
+		Name:     "profitability.baseFeeSharePercentage",
+		Usage:    "Percentage of the L2 base fee received by the proposer, used by the profitability check",
+		Value:    0,
+		Category: proposerCategory,
+	}
+	ProposeBlockGasEstimate = &cli.Uint64Flag{
+		Name: "profitability.proposeBlockGas",
+		Usage: "Estimated gas used by a TaikoL1.proposeBlock transaction excluding calldata, used by the " +
+			"profitability check when --tx.gasLimit is not set",
+		Value:    250_000,
+		Category: proposerCategory,
+	}
 )
 
 // ProposerFlags All proposer flags.
-var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
+var ProposerFlags = MergeFlags(CommonFlags, SignerFlags, []cli.Flag{
 	L2HTTPEndpoint,
 	TaikoTokenAddress,
 	L1ProposerPrivKey,
 	ProposeInterval,
 	TxPoolLocals,
 	TxPoolLocalsOnly,
+	TxPoolAllowedSenders,
+	TxPoolDeniedSenders,
+	TxPoolDeniedContracts,
+	TxPoolMaxTxsPerSender,
+	TxPoolMinTip,
+	TxPoolFeePriority,
 	ExtraData,
 	ProposeEmptyBlocksInterval,
+	AdaptiveProposeInterval,
+	MinProposeInterval,
+	MaxProposeInterval,
+	L1BaseFeeSpikePercentage,
 	MaxProposedTxListsPerEpoch,
 	ProposeBlockTxGasLimit,
 	ProposeBlockTxReplacementMultiplier,
 	ProposeBlockTxGasTipCap,
+	StuckTxTimeout,
 	ProverEndpoints,
 	OptimisticTierFee,
 	SgxTierFee,
@@ -144,6 +425,39 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	SgxAndPseZkevmTierFee,
 	TierFeePriceBump,
 	MaxTierFeePriceBumps,
+	FeeToken,
+	FeeTokenPrices,
+	FeeTokenAllowance,
+	ProverAuction,
+	ProverAuctionDeadline,
+	DryRun,
+	DryRunFile,
+	ProverRegistryURL,
+	ProverRegistryFile,
+	ProverRegistryInterval,
+	ForcedInclusionURL,
+	ForcedInclusionFile,
+	ForcedInclusionResubmitTimeout,
+	ForcedInclusionMaxResubmissions,
+	ForcedInclusionRetention,
+	LeaderElectionFile,
+	LeaderElectionID,
+	LeaderLeaseTTL,
+	ProverReputationFile,
+	ProverCooldownThreshold,
+	ProverCooldown,
+	ProverBlacklistScore,
+	ProverBlacklistDuration,
 	ProposeBlockIncludeParentMetaHash,
 	ProposerAssignmentHookAddress,
+	BlobAllowed,
+	CacheBlobForReuse,
+	PackTxListsIntoBlob,
+	TxListCodec,
+	HookCalls,
+	FixedTip,
+	TipPriorityFeePercentage,
+	ProfitabilityCheck,
+	L2BaseFeeSharePercentage,
+	ProposeBlockGasEstimate,
 })
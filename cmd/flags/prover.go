@@ -9,9 +9,9 @@ import (
 // Required flags used by prover.
 var (
 	L1ProverPrivKey = &cli.StringFlag{
-		Name:     "l1.proverPrivKey",
-		Usage:    "Private key of L1 prover, who will send TaikoL1.proveBlock transactions",
-		Required: true,
+		Name: "l1.proverPrivKey",
+		Usage: "Private key of L1 prover, who will send TaikoL1.proveBlock transactions, " +
+			"or use --signer.keystore / --signer.remote instead",
 		Category: proverCategory,
 	}
 	ProverCapacity = &cli.Uint64Flag{
@@ -84,6 +84,19 @@ var (
 		Usage:    "Minimum accepted fee for generating a SGX + PSE zkEVM proof",
 		Category: proverCategory,
 	}
+	ContractProver = &cli.StringFlag{
+		Name: "prover.contract",
+		Usage: "Address of a smart contract prover, which validates the assignment signatures of this prover " +
+			"through EIP-1271, the prover server will sign the assignments on behalf of it, and the proofs " +
+			"will be submitted through its proveBlock(uint64,bytes) method forwarding them to TaikoL1",
+		Category: proverCategory,
+	}
+	FeeTokens = &cli.StringFlag{
+		Name: "prover.feeTokens",
+		Usage: "Comma-delineated list of `token:tokenPerETH` prices of the accepted ERC-20 fee tokens, " +
+			"the minimum tier fees are converted to the token's base units with the prices",
+		Category: proverCategory,
+	}
 	// Guardian prover related.
 	GuardianProver = &cli.StringFlag{
 		Name:     "guardianProver",
@@ -139,6 +152,36 @@ var (
 		Value:    1 * time.Hour,
 		Category: proverCategory,
 	}
+	ProposerQuota = &cli.Uint64Flag{
+		Name:     "prover.proposerQuota",
+		Usage:    "Maximum number of outstanding signed assignments for a single proposer. 0 means no limit.",
+		Value:    0,
+		Category: proverCategory,
+	}
+	DynamicPricing = &cli.BoolFlag{
+		Name: "pricing.dynamic",
+		Usage: "Price the proof assignments dynamically based on the prover load, recent proving times and " +
+			"L1 gas price, the minimum tier fees will be used as the price floors",
+		Value:    false,
+		Category: proverCategory,
+	}
+	PricingMargin = &cli.Uint64Flag{
+		Name:     "pricing.margin",
+		Usage:    "Margin in percentage added to the proving costs when pricing dynamically",
+		Value:    10,
+		Category: proverCategory,
+	}
+	PricingMaxSurge = &cli.Uint64Flag{
+		Name:     "pricing.maxSurge",
+		Usage:    "Maximum surcharge in percentage when the prover is fully loaded when pricing dynamically",
+		Value:    100,
+		Category: proverCategory,
+	}
+	AdminAPIToken = &cli.StringFlag{
+		Name:     "http.adminToken",
+		Usage:    "Bearer token to access the admin API of the http server, the admin API is disabled if not set",
+		Category: proverCategory,
+	}
 	// Special flags for testing.
 	Dummy = &cli.BoolFlag{
 		Name:     "prover.dummy",
@@ -161,10 +204,10 @@ var (
 		Category: proverCategory,
 	}
 	// DB file location
-	// Required for guardian prover only
+	// Required for guardian prover, optional for other provers to persist the proof jobs
 	DatabasePath = &cli.StringFlag{
 		Name:     "db.path",
-		Usage:    "Database file location",
+		Usage:    "Database file location, proof jobs will be persisted in it to survive restarts",
 		Category: proverCategory,
 	}
 	DatabaseCacheSize = &cli.Uint64Flag{
@@ -186,7 +229,7 @@ var (
 )
 
 // ProverFlags All prover flags.
-var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
+var ProverFlags = MergeFlags(CommonFlags, SignerFlags, []cli.Flag{
 	L1HTTPEndpoint,
 	L2WSEndpoint,
 	L2HTTPEndpoint,
@@ -198,6 +241,8 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	MinSgxTierFee,
 	MinPseZkevmTierFee,
 	MinSgxAndPseZkevmTierFee,
+	ContractProver,
+	FeeTokens,
 	StartingBlockID,
 	Dummy,
 	GuardianProver,
@@ -213,6 +258,11 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	ProverHTTPServerPort,
 	ProverCapacity,
 	MaxExpiry,
+	ProposerQuota,
+	DynamicPricing,
+	PricingMargin,
+	PricingMaxSurge,
+	AdminAPIToken,
 	MaxProposedIn,
 	TaikoTokenAddress,
 	MaxAcceptableBlockSlippage,
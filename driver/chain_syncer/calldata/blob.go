@@ -0,0 +1,151 @@
+package calldata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+var (
+	errNoBlobSource = errors.New("no blob source configured, can not derive blob-backed proposals")
+	// L1 block time, used to convert the protocol's blob expiry to an L1 blocks range.
+	l1BlockTime = uint64(12)
+)
+
+// fetchBlobTxList fetches the transactions list bytes of the given blob-backed proposal,
+// from the blob it refers to.
+func (s *Syncer) fetchBlobTxList(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+) ([]byte, error) {
+	if s.blobSource == nil {
+		return nil, errNoBlobSource
+	}
+
+	blobHash := common.BytesToHash(event.Meta.BlobHash[:])
+
+	l1Header, err := s.blobOriginHeader(ctx, event, blobHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the L1 block which carried blob %s: %w", blobHash, err)
+	}
+
+	blob, err := s.blobSource.GetBlob(ctx, l1Header, blobHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch blob %s: %w", blobHash, err)
+	}
+
+	var (
+		data   = rpc.DecodeBlob(blob)
+		offset = event.Meta.TxListByteOffset.Uint64()
+		size   = event.Meta.TxListByteSize.Uint64()
+	)
+	if offset+size > uint64(len(data)) {
+		log.Info(
+			"Invalid transactions list offset and size in blob",
+			"blockID", event.BlockId,
+			"blobHash", blobHash,
+			"offset", offset,
+			"size", size,
+		)
+		return []byte{}, nil
+	}
+
+	log.Info(
+		"Transactions list fetched from blob",
+		"blockID", event.BlockId,
+		"blobHash", blobHash,
+		"l1Height", l1Header.Number,
+		"offset", offset,
+		"size", size,
+	)
+
+	return data[offset : offset+size], nil
+}
+
+// blobOriginHeader returns the header of the L1 block which carried the given blob. If the proposing transaction
+// doesn't carry the blob itself, the proposal reused a blob cached by the protocol, which was carried by
+// an earlier L1 block, emitting a `BlobCached` event.
+func (s *Syncer) blobOriginHeader(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+	blobHash common.Hash,
+) (*types.Header, error) {
+	tx, err := s.rpc.L1.TransactionInBlock(ctx, event.Raw.BlockHash, event.Raw.TxIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch original TaikoL1.proposeBlock transaction: %w", err)
+	}
+
+	// The blob was carried by the proposing transaction itself, record its origin in case it has been
+	// cached for reuse.
+	for _, hash := range tx.BlobHashes() {
+		if hash != blobHash {
+			continue
+		}
+
+		header, err := s.rpc.L1.HeaderByHash(ctx, event.Raw.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		s.cacheBlobOrigin(blobHash, header)
+
+		return header, nil
+	}
+
+	if header, ok := s.blobOrigins[blobHash]; ok {
+		return header, nil
+	}
+
+	// Search the `BlobCached` events within the blob expiry.
+	var (
+		end   = event.Raw.BlockNumber - 1
+		start = uint64(0)
+	)
+	if blocks := s.blobExpiry / l1BlockTime; end > blocks {
+		start = end - blocks
+	}
+
+	iter, err := s.rpc.TaikoL1.FilterBlobCached(&bind.FilterOpts{Context: ctx, Start: start, End: &end})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var originHash *common.Hash
+	for iter.Next() {
+		if iter.Event.BlobHash == blobHash {
+			originHash = &iter.Event.Raw.BlockHash
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	if originHash == nil {
+		return nil, fmt.Errorf("no BlobCached event found between L1 blocks %d and %d", start, end)
+	}
+
+	header, err := s.rpc.L1.HeaderByHash(ctx, *originHash)
+	if err != nil {
+		return nil, err
+	}
+	s.cacheBlobOrigin(blobHash, header)
+
+	return header, nil
+}
+
+// cacheBlobOrigin records the L1 block which carried the given blob, and removes all expired records.
+func (s *Syncer) cacheBlobOrigin(blobHash common.Hash, header *types.Header) {
+	for hash, h := range s.blobOrigins {
+		if h.Time+s.blobExpiry < header.Time {
+			delete(s.blobOrigins, hash)
+		}
+	}
+
+	s.blobOrigins[blobHash] = header
+}
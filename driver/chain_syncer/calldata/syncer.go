@@ -20,8 +20,10 @@ import (
 	"github.com/taikoxyz/taiko-client/driver/chain_syncer/beaconsync"
 	"github.com/taikoxyz/taiko-client/driver/state"
 	"github.com/taikoxyz/taiko-client/metrics"
+	blobsource "github.com/taikoxyz/taiko-client/pkg/blob_source"
 	eventIterator "github.com/taikoxyz/taiko-client/pkg/chain_iterator/event_iterator"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/txlistcodec"
 	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlistvalidator"
 )
 
@@ -39,6 +41,9 @@ type Syncer struct {
 	progressTracker   *beaconsync.SyncProgressTracker          // Sync progress tracker
 	anchorConstructor *anchorTxConstructor.AnchorTxConstructor // TaikoL2.anchor transactions constructor
 	txListValidator   *txListValidator.TxListValidator         // Transactions list validator
+	blobSource        blobsource.BlobSource                    // Blob source for blob-backed proposals
+	blobOrigins       map[common.Hash]*types.Header            // L1 blocks which carried the cached blobs
+	blobExpiry        uint64                                   // Protocol's cached blob expiry in seconds
 	// Used by BlockInserter
 	lastInsertedBlockID *big.Int
 	reorgDetectedFlag   bool
@@ -51,6 +56,8 @@ func NewSyncer(
 	state *state.State,
 	progressTracker *beaconsync.SyncProgressTracker,
 	signalServiceAddress common.Address,
+	blobSource blobsource.BlobSource,
+	txListCodec txlistcodec.Codec,
 ) (*Syncer, error) {
 	configs, err := rpc.TaikoL1.GetConfig(&bind.CallOpts{Context: ctx})
 	if err != nil {
@@ -73,7 +80,11 @@ func NewSyncer(
 			defaultMaxTxPerBlock,
 			configs.BlockMaxTxListBytes.Uint64(),
 			rpc.L2ChainID,
+			txListCodec,
 		),
+		blobSource:  blobSource,
+		blobOrigins: make(map[common.Hash]*types.Header),
+		blobExpiry:  configs.BlobExpiry.Uint64(),
 	}, nil
 }
 
@@ -227,19 +238,31 @@ func (s *Syncer) onBlockProposed(
 
 	log.Debug("Parent block", "height", parent.Number, "hash", parent.Hash())
 
-	tx, err := s.rpc.L1.TransactionInBlock(
-		ctx,
-		event.Raw.BlockHash,
-		event.Raw.TxIndex,
+	// Check whether the transactions list is valid.
+	var (
+		txListBytes    []byte
+		hint           txListValidator.InvalidTxListReason
+		invalidTxIndex int
 	)
-	if err != nil {
-		return fmt.Errorf("failed to fetch original TaikoL1.proposeBlock transaction: %w", err)
-	}
+	if event.Meta.BlobUsed {
+		if txListBytes, err = s.fetchBlobTxList(ctx, event); err != nil {
+			return err
+		}
+		txListBytes, hint, invalidTxIndex = s.txListValidator.ValidateTxListBytes(event.BlockId, txListBytes)
+	} else {
+		tx, err := s.rpc.L1.TransactionInBlock(
+			ctx,
+			event.Raw.BlockHash,
+			event.Raw.TxIndex,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to fetch original TaikoL1.proposeBlock transaction: %w", err)
+		}
 
-	// Check whether the transactions list is valid.
-	txListBytes, hint, invalidTxIndex, err := s.txListValidator.ValidateTxList(event.BlockId, tx.Data())
-	if err != nil {
-		return fmt.Errorf("failed to validate transactions list: %w", err)
+		txListBytes, hint, invalidTxIndex, err = s.txListValidator.ValidateTxList(event.BlockId, tx.Data())
+		if err != nil {
+			return fmt.Errorf("failed to validate transactions list: %w", err)
+		}
 	}
 
 	log.Info(
@@ -9,11 +9,17 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/suite"
 	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/driver/chain_syncer/beaconsync"
 	"github.com/taikoxyz/taiko-client/driver/state"
+	blobsource "github.com/taikoxyz/taiko-client/pkg/blob_source"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/txlistcodec"
 	"github.com/taikoxyz/taiko-client/proposer"
 	"github.com/taikoxyz/taiko-client/testutils"
 
@@ -38,16 +44,25 @@ func (s *CalldataSyncerTestSuite) SetupTest() {
 		state,
 		beaconsync.NewSyncProgressTracker(s.RPCClient.L2, 1*time.Hour),
 		common.HexToAddress(os.Getenv("L1_SIGNAL_SERVICE_CONTRACT_ADDRESS")),
+		nil,
+		nil,
 	)
 	s.Nil(err)
 	s.s = syncer
 
 	prop := new(proposer.Proposer)
+	s.Nil(proposer.InitFromConfig(context.Background(), prop, s.proposerConfig()))
+
+	s.p = prop
+}
+
+// proposerConfig returns the default configurations of the proposers used in tests.
+func (s *CalldataSyncerTestSuite) proposerConfig() *proposer.Config {
 	l1ProposerPrivKey, err := crypto.ToECDSA(common.FromHex(os.Getenv("L1_PROPOSER_PRIVATE_KEY")))
 	s.Nil(err)
 	proposeInterval := 1024 * time.Hour // No need to periodically propose transactions list in unit tests
 
-	s.Nil(proposer.InitFromConfig(context.Background(), prop, (&proposer.Config{
+	return &proposer.Config{
 		L1Endpoint:                 os.Getenv("L1_NODE_WS_ENDPOINT"),
 		L2Endpoint:                 os.Getenv("L2_EXECUTION_ENGINE_WS_ENDPOINT"),
 		TaikoL1Address:             common.HexToAddress(os.Getenv("TAIKO_L1_ADDRESS")),
@@ -65,9 +80,89 @@ func (s *CalldataSyncerTestSuite) SetupTest() {
 		SgxAndPseZkevmTierFee:      common.Big256,
 		MaxTierFeePriceBumps:       3,
 		TierFeePriceBump:           common.Big2,
-	})))
+	}
+}
 
-	s.p = prop
+// sendL2Txs sends the given number of transactions with the given gas limit to the L2 execution engine's
+// transactions pool.
+func (s *CalldataSyncerTestSuite) sendL2Txs(num int, gasLimit uint64) types.Transactions {
+	l2Head, err := s.RPCClient.L2.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+
+	baseFee, err := s.RPCClient.TaikoL2.GetBasefee(nil, 0, uint32(l2Head.GasUsed))
+	s.Nil(err)
+
+	nonce, err := s.RPCClient.L2.PendingNonceAt(context.Background(), s.TestAddr)
+	s.Nil(err)
+
+	var txs types.Transactions
+	for i := 0; i < num; i++ {
+		tx, err := types.SignTx(
+			types.NewTransaction(
+				nonce+uint64(i),
+				common.BytesToAddress(testutils.RandomBytes(32)),
+				common.Big1,
+				gasLimit,
+				baseFee,
+				[]byte{},
+			),
+			types.LatestSignerForChainID(s.RPCClient.L2ChainID),
+			s.TestAddrPrivKey,
+		)
+		s.Nil(err)
+		s.Nil(s.RPCClient.L2.SendTransaction(context.Background(), tx))
+
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
+
+// proposeAndSync runs a proposing operation of the given proposer, waits for the given number of
+// `BlockProposed` events, and then inserts the proposed blocks through the given syncer.
+func (s *CalldataSyncerTestSuite) proposeAndSync(
+	p *proposer.Proposer,
+	syncer *Syncer,
+	num int,
+) []*bindings.TaikoL1ClientBlockProposed {
+	sink := make(chan *bindings.TaikoL1ClientBlockProposed, num)
+	sub, err := s.RPCClient.TaikoL1.WatchBlockProposed(nil, sink, nil, nil)
+	s.Nil(err)
+	defer sub.Unsubscribe()
+
+	s.Nil(p.ProposeOp(context.Background()))
+
+	var events []*bindings.TaikoL1ClientBlockProposed
+	for i := 0; i < num; i++ {
+		events = append(events, <-sink)
+	}
+
+	l1Head, err := s.RPCClient.L1.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	s.Nil(syncer.ProcessL1Blocks(ctx, l1Head))
+
+	return events
+}
+
+// newSyncer creates a new syncer sharing the state of the default one, with the given blob source
+// and transactions list codec.
+func (s *CalldataSyncerTestSuite) newSyncer(blobSource blobsource.BlobSource, codec txlistcodec.Codec) *Syncer {
+	syncer, err := NewSyncer(
+		context.Background(),
+		s.RPCClient,
+		s.s.state,
+		s.s.progressTracker,
+		common.HexToAddress(os.Getenv("L1_SIGNAL_SERVICE_CONTRACT_ADDRESS")),
+		blobSource,
+		codec,
+	)
+	s.Nil(err)
+
+	return syncer
 }
 func (s *CalldataSyncerTestSuite) TestCancelNewSyncer() {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -78,6 +173,8 @@ func (s *CalldataSyncerTestSuite) TestCancelNewSyncer() {
 		s.s.state,
 		s.s.progressTracker,
 		common.HexToAddress(os.Getenv("L1_SIGNAL_SERVICE_CONTRACT_ADDRESS")),
+		nil,
+		nil,
 	)
 	s.Nil(syncer)
 	s.NotNil(err)
@@ -211,6 +308,114 @@ func (s *CalldataSyncerTestSuite) TestTreasuryIncome() {
 	s.Zero(balanceAfter.Cmp(balance))
 }
 
+func (s *CalldataSyncerTestSuite) TestProcessL1BlocksBlobUsed() {
+	blobSource, err := blobsource.NewLocalSource(s.T().TempDir())
+	s.Nil(err)
+	syncer := s.newSyncer(blobSource, nil)
+
+	cfg := s.proposerConfig()
+	cfg.BlobAllowed = true
+	cfg.PackTxListsIntoBlob = true
+	cfg.MaxProposedTxListsPerEpoch = 2
+	prop := new(proposer.Proposer)
+	s.Nil(proposer.InitFromConfig(context.Background(), prop, cfg))
+
+	// Each transaction takes more than half of the block gas limit, so they will be proposed in two
+	// transactions lists sharing one blob, the first proposal carries and caches the blob, and the
+	// second one reuses it.
+	protocolConfigs, err := s.RPCClient.TaikoL1.GetConfig(nil)
+	s.Nil(err)
+	txs := s.sendL2Txs(2, uint64(protocolConfigs.BlockMaxGasLimit)/2+1)
+
+	var data []byte
+	for _, tx := range txs {
+		txListBytes, err := rlp.EncodeToBytes(types.Transactions{tx})
+		s.Nil(err)
+		data = append(data, txListBytes...)
+	}
+	blob, err := rpc.EncodeBlob(data)
+	s.Nil(err)
+	blobHash, err := blobSource.StoreBlob(blob)
+	s.Nil(err)
+
+	events := s.proposeAndSync(prop, syncer, len(txs))
+	for i, event := range events {
+		s.True(event.Meta.BlobUsed)
+		s.Equal(blobHash, common.BytesToHash(event.Meta.BlobHash[:]))
+
+		proposeTx, err := s.RPCClient.L1.TransactionInBlock(context.Background(), event.Raw.BlockHash, event.Raw.TxIndex)
+		s.Nil(err)
+		if i == 0 {
+			s.Equal([]common.Hash{blobHash}, proposeTx.BlobHashes())
+		} else {
+			s.Empty(proposeTx.BlobHashes())
+		}
+
+		block, err := s.RPCClient.L2.BlockByNumber(context.Background(), event.BlockId)
+		s.Nil(err)
+		s.Equal(2, block.Transactions().Len())
+		s.Equal(txs[i].Hash(), block.Transactions()[1].Hash())
+	}
+}
+
+func (s *CalldataSyncerTestSuite) TestProcessL1BlocksWithCodec() {
+	for _, name := range []string{txlistcodec.Zlib, txlistcodec.Brotli} {
+		codec, err := txlistcodec.New(name)
+		s.Nil(err)
+
+		cfg := s.proposerConfig()
+		cfg.TxListCodec = name
+		prop := new(proposer.Proposer)
+		s.Nil(proposer.InitFromConfig(context.Background(), prop, cfg))
+
+		txs := s.sendL2Txs(2, 21000)
+		events := s.proposeAndSync(prop, s.newSyncer(nil, codec), 1)
+
+		// The proposed transactions list should be encoded by the codec.
+		proposeTx, err := s.RPCClient.L1.TransactionInBlock(
+			context.Background(),
+			events[0].Raw.BlockHash,
+			events[0].Raw.TxIndex,
+		)
+		s.Nil(err)
+		encoded, err := encoding.UnpackTxListBytes(proposeTx.Data())
+		s.Nil(err)
+		txListBytes, err := codec.Decode(encoded)
+		s.Nil(err)
+		var proposed types.Transactions
+		s.Nil(rlp.DecodeBytes(txListBytes, &proposed))
+
+		// The derived L2 block should contain exactly the proposed transactions, after the anchor transaction.
+		block, err := s.RPCClient.L2.BlockByNumber(context.Background(), events[0].BlockId)
+		s.Nil(err)
+		s.Equal(proposed.Len()+1, block.Transactions().Len())
+		for i, tx := range proposed {
+			s.Equal(tx.Hash(), block.Transactions()[i+1].Hash())
+		}
+		for _, tx := range txs {
+			s.NotNil(block.Transaction(tx.Hash()))
+		}
+	}
+}
+
+func (s *CalldataSyncerTestSuite) TestProcessL1BlocksCodecMismatch() {
+	cfg := s.proposerConfig()
+	cfg.TxListCodec = txlistcodec.Zlib
+	prop := new(proposer.Proposer)
+	s.Nil(proposer.InitFromConfig(context.Background(), prop, cfg))
+
+	codec, err := txlistcodec.New(txlistcodec.Brotli)
+	s.Nil(err)
+
+	s.sendL2Txs(1, 21000)
+	events := s.proposeAndSync(prop, s.newSyncer(nil, codec), 1)
+
+	// A transactions list encoded by another codec is invalid, so only the anchor transaction is included.
+	block, err := s.RPCClient.L2.BlockByNumber(context.Background(), events[0].BlockId)
+	s.Nil(err)
+	s.Equal(1, block.Transactions().Len())
+}
+
 func TestCalldataSyncerTestSuite(t *testing.T) {
 	suite.Run(t, new(CalldataSyncerTestSuite))
 }
@@ -11,7 +11,9 @@ import (
 	"github.com/taikoxyz/taiko-client/driver/chain_syncer/beaconsync"
 	"github.com/taikoxyz/taiko-client/driver/chain_syncer/calldata"
 	"github.com/taikoxyz/taiko-client/driver/state"
+	blobsource "github.com/taikoxyz/taiko-client/pkg/blob_source"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/txlistcodec"
 )
 
 // L2ChainSyncer is responsible for keeping the L2 execution engine's local chain in sync with the one
@@ -41,12 +43,22 @@ func New(
 	p2pSyncVerifiedBlocks bool,
 	p2pSyncTimeout time.Duration,
 	signalServiceAddress common.Address,
+	blobSource blobsource.BlobSource,
+	txListCodec txlistcodec.Codec,
 ) (*L2ChainSyncer, error) {
 	tracker := beaconsync.NewSyncProgressTracker(rpc.L2, p2pSyncTimeout)
 	go tracker.Track(ctx)
 
 	beaconSyncer := beaconsync.NewSyncer(ctx, rpc, state, tracker)
-	calldataSyncer, err := calldata.NewSyncer(ctx, rpc, state, tracker, signalServiceAddress)
+	calldataSyncer, err := calldata.NewSyncer(
+		ctx,
+		rpc,
+		state,
+		tracker,
+		signalServiceAddress,
+		blobSource,
+		txListCodec,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +39,8 @@ func (s *ChainSyncerTestSuite) SetupTest() {
 		false,
 		1*time.Hour,
 		common.HexToAddress(os.Getenv("L1_SIGNAL_SERVICE_CONTRACT_ADDRESS")),
+		nil,
+		nil,
 	)
 	s.Nil(err)
 	s.s = syncer
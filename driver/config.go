@@ -24,6 +24,9 @@ type Config struct {
 	P2PSyncTimeout        time.Duration
 	BackOffRetryInterval  time.Duration
 	RPCTimeout            *time.Duration
+	L1BeaconEndpoint      string
+	BlobLocalSource       string
+	TxListCodec           string
 }
 
 // NewConfigFromCliContext creates a new config instance from
@@ -62,5 +65,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		P2PSyncTimeout:        c.Duration(flags.P2PSyncTimeout.Name),
 		BackOffRetryInterval:  c.Duration(flags.BackOffRetryInterval.Name),
 		RPCTimeout:            timeout,
+		L1BeaconEndpoint:      c.String(flags.L1BeaconEndpoint.Name),
+		BlobLocalSource:       c.String(flags.BlobLocalSource.Name),
+		TxListCodec:           c.String(flags.TxListCodec.Name),
 	}, nil
 }
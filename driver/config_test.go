@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/taikoxyz/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-client/pkg/txlistcodec"
 	"github.com/urfave/cli/v2"
 )
 
@@ -35,6 +36,8 @@ func (s *DriverTestSuite) TestNewConfigFromCliContext() {
 		s.Nil(new(Driver).InitFromCli(context.Background(), ctx))
 		s.True(c.P2PSyncVerifiedBlocks)
 		s.Equal("http://localhost:8545", c.L2CheckPoint)
+		s.Equal("http://localhost:5052", c.L1BeaconEndpoint)
+		s.Equal(txlistcodec.Zlib, c.TxListCodec)
 
 		return err
 	}
@@ -51,6 +54,8 @@ func (s *DriverTestSuite) TestNewConfigFromCliContext() {
 		"--" + flags.RPCTimeout.Name, "5s",
 		"--" + flags.P2PSyncVerifiedBlocks.Name,
 		"--" + flags.CheckPointSyncURL.Name, "http://localhost:8545",
+		"--" + flags.L1BeaconEndpoint.Name, "http://localhost:5052",
+		"--" + flags.TxListCodec.Name, txlistcodec.Zlib,
 	}))
 }
 
@@ -85,6 +90,9 @@ func (s *DriverTestSuite) SetupApp() *cli.App {
 		&cli.DurationFlag{Name: flags.P2PSyncTimeout.Name},
 		&cli.DurationFlag{Name: flags.RPCTimeout.Name},
 		&cli.StringFlag{Name: flags.CheckPointSyncURL.Name},
+		&cli.StringFlag{Name: flags.L1BeaconEndpoint.Name},
+		&cli.StringFlag{Name: flags.BlobLocalSource.Name},
+		&cli.StringFlag{Name: flags.TxListCodec.Name},
 	}
 	app.Action = func(ctx *cli.Context) error {
 		_, err := NewConfigFromCliContext(ctx)
@@ -15,7 +15,9 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	chainSyncer "github.com/taikoxyz/taiko-client/driver/chain_syncer"
 	"github.com/taikoxyz/taiko-client/driver/state"
+	blobsource "github.com/taikoxyz/taiko-client/pkg/blob_source"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/txlistcodec"
 	"github.com/urfave/cli/v2"
 )
 
@@ -94,6 +96,16 @@ func InitFromConfig(ctx context.Context, d *Driver, cfg *Config) (err error) {
 		return err
 	}
 
+	blobSource, err := blobsource.New(cfg.L1BeaconEndpoint, cfg.BlobLocalSource)
+	if err != nil {
+		return err
+	}
+
+	txListCodec, err := txlistcodec.New(cfg.TxListCodec)
+	if err != nil {
+		return err
+	}
+
 	if d.l2ChainSyncer, err = chainSyncer.New(
 		d.ctx,
 		d.rpc,
@@ -101,6 +113,8 @@ func InitFromConfig(ctx context.Context, d *Driver, cfg *Config) (err error) {
 		cfg.P2PSyncVerifiedBlocks,
 		cfg.P2PSyncTimeout,
 		signalServiceAddress,
+		blobSource,
+		txListCodec,
 	); err != nil {
 		return err
 	}
@@ -24,9 +24,24 @@ var (
 	DriverL2VerifiedHeightGauge = metrics.NewRegisteredGauge("driver/l2Verified/id", nil)
 
 	// Proposer
-	ProposerProposeEpochCounter    = metrics.NewRegisteredCounter("proposer/epoch", nil)
-	ProposerProposedTxListsCounter = metrics.NewRegisteredCounter("proposer/proposed/txLists", nil)
-	ProposerProposedTxsCounter     = metrics.NewRegisteredCounter("proposer/proposed/txs", nil)
+	ProposerProposeEpochCounter        = metrics.NewRegisteredCounter("proposer/epoch", nil)
+	ProposerProposedTxListsCounter     = metrics.NewRegisteredCounter("proposer/proposed/txLists", nil)
+	ProposerProposedTxsCounter         = metrics.NewRegisteredCounter("proposer/proposed/txs", nil)
+	ProposerUnprofitableTxListsCounter = metrics.NewRegisteredCounter("proposer/unprofitable/txLists", nil)
+	ProposerSimulationFailedCounter    = metrics.NewRegisteredCounter("proposer/simulation/failed", nil)
+	ProposerIntervalGauge              = metrics.NewRegisteredGauge("proposer/interval", nil)
+	ProposerIntervalDecisionGauge      = metrics.NewRegisteredGauge("proposer/interval/decision", nil)
+	ProposerPendingBytesGauge          = metrics.NewRegisteredGauge("proposer/pool/pendingBytes", nil)
+	ProposerFullTxListsGauge           = metrics.NewRegisteredGauge("proposer/pool/fullTxLists", nil)
+	ProposerDryRunTxListsCounter       = metrics.NewRegisteredCounter("proposer/dryRun/txLists", nil)
+	ProposerDryRunTxsCounter           = metrics.NewRegisteredCounter("proposer/dryRun/txs", nil)
+	ProposerDryRunGasGauge             = metrics.NewRegisteredGauge("proposer/dryRun/gas", nil)
+	ProposerDryRunProverFeeGauge       = metrics.NewRegisteredGauge("proposer/dryRun/proverFee", nil)
+	ProposerForcedTxsQueuedGauge       = metrics.NewRegisteredGauge("proposer/forcedTxs/queued", nil)
+	ProposerForcedTxsProposedCounter   = metrics.NewRegisteredCounter("proposer/forcedTxs/proposed", nil)
+	ProposerForcedTxsResubmitCounter   = metrics.NewRegisteredCounter("proposer/forcedTxs/resubmit", nil)
+	ProposerForcedTxsIncludedCounter   = metrics.NewRegisteredCounter("proposer/forcedTxs/included", nil)
+	ProposerForcedTxsDroppedCounter    = metrics.NewRegisteredCounter("proposer/forcedTxs/dropped", nil)
 
 	// Prover
 	ProverLatestVerifiedIDGauge      = metrics.NewRegisteredGauge("prover/latestVerified/id", nil)
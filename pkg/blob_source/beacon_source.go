@@ -0,0 +1,154 @@
+package blobsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/go-resty/resty/v2"
+)
+
+// BeaconSource fetches blobs through a beacon node's blob sidecars API.
+type BeaconSource struct {
+	endpoint *url.URL
+	client   *resty.Client
+
+	mutex          sync.Mutex
+	genesisTime    uint64
+	secondsPerSlot uint64
+}
+
+// blobSidecar is a blob sidecar returned by the beacon node's `/eth/v1/beacon/blob_sidecars/{block_id}` API.
+type blobSidecar struct {
+	Index         string        `json:"index"`
+	Blob          string        `json:"blob"`
+	KZGCommitment hexutil.Bytes `json:"kzg_commitment"`
+	KZGProof      hexutil.Bytes `json:"kzg_proof"`
+}
+
+// NewBeaconSource creates a new BeaconSource instance.
+func NewBeaconSource(endpoint *url.URL) *BeaconSource {
+	return &BeaconSource{endpoint: endpoint, client: resty.New()}
+}
+
+// GetBlob implements the BlobSource interface.
+func (s *BeaconSource) GetBlob(
+	ctx context.Context,
+	l1Header *types.Header,
+	blobHash common.Hash,
+) (*kzg4844.Blob, error) {
+	slot, err := s.slotAt(ctx, l1Header.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Data []*blobSidecar `json:"data"`
+	}
+	if err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%d", slot), &result); err != nil {
+		return nil, err
+	}
+
+	for _, sidecar := range result.Data {
+		var commitment kzg4844.Commitment
+		if len(sidecar.KZGCommitment) != len(commitment) {
+			return nil, fmt.Errorf("invalid KZG commitment length: %d", len(sidecar.KZGCommitment))
+		}
+		copy(commitment[:], sidecar.KZGCommitment)
+
+		if KZGToVersionedHash(commitment) != blobHash {
+			continue
+		}
+
+		blob, err := decodeBlob(sidecar.Blob)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkBlobHash(blob, blobHash); err != nil {
+			return nil, err
+		}
+
+		return blob, nil
+	}
+
+	log.Debug("Blob not found in beacon node", "slot", slot, "blobHash", blobHash, "sidecars", len(result.Data))
+
+	return nil, ErrBlobNotFound
+}
+
+// slotAt returns the beacon chain slot of the given L1 block timestamp.
+func (s *BeaconSource) slotAt(ctx context.Context, timestamp uint64) (uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.secondsPerSlot == 0 {
+		var genesis struct {
+			Data struct {
+				GenesisTime string `json:"genesis_time"`
+			} `json:"data"`
+		}
+		if err := s.get(ctx, "/eth/v1/beacon/genesis", &genesis); err != nil {
+			return 0, err
+		}
+
+		var spec struct {
+			Data struct {
+				SecondsPerSlot string `json:"SECONDS_PER_SLOT"`
+			} `json:"data"`
+		}
+		if err := s.get(ctx, "/eth/v1/config/spec", &spec); err != nil {
+			return 0, err
+		}
+
+		genesisTime, err := strconv.ParseUint(genesis.Data.GenesisTime, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid genesis time: %w", err)
+		}
+		secondsPerSlot, err := strconv.ParseUint(spec.Data.SecondsPerSlot, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid seconds per slot: %w", err)
+		}
+		if secondsPerSlot == 0 {
+			return 0, errors.New("zero seconds per slot")
+		}
+
+		s.genesisTime, s.secondsPerSlot = genesisTime, secondsPerSlot
+	}
+
+	if timestamp < s.genesisTime {
+		return 0, fmt.Errorf("timestamp %d before beacon genesis %d", timestamp, s.genesisTime)
+	}
+
+	return (timestamp - s.genesisTime) / s.secondsPerSlot, nil
+}
+
+// get sends a GET request to the given beacon node API path, and decodes the JSON response.
+func (s *BeaconSource) get(ctx context.Context, path string, result interface{}) error {
+	requestURL, err := url.JoinPath(s.endpoint.String(), path)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		ForceContentType("application/json").
+		SetResult(result).
+		Get(requestURL)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("unsuccessful response %d from %s", resp.StatusCode(), path)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package blobsource
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	ErrBlobNotFound = errors.New("blob not found")
+)
+
+// BlobSource is a data source which the blobs carried by L1 blocks can be fetched from.
+type BlobSource interface {
+	// GetBlob fetches the blob with the given versioned hash, which was carried by the given L1 block.
+	GetBlob(ctx context.Context, l1Header *types.Header, blobHash common.Hash) (*kzg4844.Blob, error)
+}
+
+// New creates a new blob source based on the given endpoints, the beacon node endpoint
+// takes precedence, the local endpoint should only be used for testing purposes.
+func New(beaconEndpoint string, localEndpoint string) (BlobSource, error) {
+	if len(beaconEndpoint) != 0 {
+		endpoint, err := url.Parse(beaconEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid beacon node endpoint: %w", err)
+		}
+		return NewBeaconSource(endpoint), nil
+	}
+
+	if len(localEndpoint) != 0 {
+		return NewLocalSource(localEndpoint)
+	}
+
+	return nil, nil
+}
+
+// KZGToVersionedHash computes the versioned hash of the given KZG commitment, as defined in EIP-4844.
+func KZGToVersionedHash(commitment kzg4844.Commitment) common.Hash {
+	hash := sha256.Sum256(commitment[:])
+	hash[0] = params.BlobTxHashVersion
+
+	return hash
+}
+
+// decodeBlob decodes a 0x-prefixed hex encoded blob.
+func decodeBlob(encoded string) (*kzg4844.Blob, error) {
+	b, err := hexutil.Decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != len(kzg4844.Blob{}) {
+		return nil, fmt.Errorf("invalid blob length: %d", len(b))
+	}
+
+	var blob kzg4844.Blob
+	copy(blob[:], b)
+
+	return &blob, nil
+}
+
+// checkBlobHash checks whether the given blob matches the given versioned hash.
+func checkBlobHash(blob *kzg4844.Blob, blobHash common.Hash) error {
+	commitment, err := kzg4844.BlobToCommitment(*blob)
+	if err != nil {
+		return err
+	}
+
+	if hash := KZGToVersionedHash(commitment); hash != blobHash {
+		return fmt.Errorf("blob hash mismatch, expected %s, got %s", blobHash, hash)
+	}
+
+	return nil
+}
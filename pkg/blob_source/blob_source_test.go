@@ -0,0 +1,107 @@
+package blobsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBlob(t *testing.T) (*kzg4844.Blob, kzg4844.Commitment) {
+	var blob kzg4844.Blob
+	for i := 0; i < len(blob); i += 32 {
+		blob[i+31] = byte(i / 32)
+	}
+
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	require.Nil(t, err)
+
+	return &blob, commitment
+}
+
+func TestLocalSourceDir(t *testing.T) {
+	source, err := NewLocalSource(t.TempDir())
+	require.Nil(t, err)
+
+	blob, commitment := newTestBlob(t)
+	blobHash, err := source.StoreBlob(blob)
+	require.Nil(t, err)
+	require.Equal(t, KZGToVersionedHash(commitment), blobHash)
+
+	fetched, err := source.GetBlob(context.Background(), nil, blobHash)
+	require.Nil(t, err)
+	require.Equal(t, blob, fetched)
+
+	_, err = source.GetBlob(context.Background(), nil, common.Hash{})
+	require.ErrorIs(t, err, ErrBlobNotFound)
+}
+
+func TestLocalSourceHTTP(t *testing.T) {
+	dir := t.TempDir()
+	local, err := NewLocalSource(dir)
+	require.Nil(t, err)
+
+	blob, _ := newTestBlob(t)
+	blobHash, err := local.StoreBlob(blob)
+	require.Nil(t, err)
+
+	srv := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	defer srv.Close()
+
+	source, err := New("", srv.URL)
+	require.Nil(t, err)
+
+	fetched, err := source.GetBlob(context.Background(), nil, blobHash)
+	require.Nil(t, err)
+	require.Equal(t, blob, fetched)
+
+	_, err = source.GetBlob(context.Background(), nil, common.Hash{})
+	require.ErrorIs(t, err, ErrBlobNotFound)
+}
+
+func TestBeaconSource(t *testing.T) {
+	blob, commitment := newTestBlob(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"genesis_time":"100"}}`)
+	})
+	mux.HandleFunc("/eth/v1/config/spec", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"SECONDS_PER_SLOT":"12"}}`)
+	})
+	mux.HandleFunc("/eth/v1/beacon/blob_sidecars/10", func(w http.ResponseWriter, r *http.Request) {
+		require.Nil(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []*blobSidecar{{
+				Index:         "0",
+				Blob:          hexutil.Encode(blob[:]),
+				KZGCommitment: commitment[:],
+			}},
+		}))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	endpoint, err := url.Parse(srv.URL)
+	require.Nil(t, err)
+
+	source := NewBeaconSource(endpoint)
+
+	fetched, err := source.GetBlob(context.Background(), &types.Header{Time: 220}, KZGToVersionedHash(commitment))
+	require.Nil(t, err)
+	require.Equal(t, blob, fetched)
+
+	_, err = source.GetBlob(context.Background(), &types.Header{Time: 220}, common.Hash{})
+	require.ErrorIs(t, err, ErrBlobNotFound)
+
+	_, err = source.GetBlob(context.Background(), &types.Header{Time: 232}, common.Hash{})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,132 @@
+package blobsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/go-resty/resty/v2"
+)
+
+// LocalSource is a blob source which should only be used for testing purposes, it reads
+// 0x-prefixed hex encoded blobs named by their versioned hashes from a local directory, or
+// from a HTTP server serving such a directory.
+type LocalSource struct {
+	dir      string
+	endpoint *url.URL
+	client   *resty.Client
+}
+
+// NewLocalSource creates a new LocalSource instance, the given endpoint can either be a local
+// directory path or a HTTP(S) URL.
+func NewLocalSource(endpoint string) (*LocalSource, error) {
+	if strings.HasPrefix(endpoint, "http://") || strings.HasPrefix(endpoint, "https://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid local blob source endpoint: %w", err)
+		}
+		return &LocalSource{endpoint: u, client: resty.New()}, nil
+	}
+
+	if err := os.MkdirAll(endpoint, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create local blob source directory: %w", err)
+	}
+
+	return &LocalSource{dir: endpoint}, nil
+}
+
+// GetBlob implements the BlobSource interface.
+func (s *LocalSource) GetBlob(
+	ctx context.Context,
+	_ *types.Header,
+	blobHash common.Hash,
+) (*kzg4844.Blob, error) {
+	var (
+		encoded string
+		err     error
+	)
+	if s.endpoint != nil {
+		encoded, err = s.fetch(ctx, blobHash)
+	} else {
+		encoded, err = s.read(blobHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	blob, err := decodeBlob(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBlobHash(blob, blobHash); err != nil {
+		return nil, err
+	}
+
+	return blob, nil
+}
+
+// StoreBlob saves the given blob into the local directory, and returns its versioned hash.
+func (s *LocalSource) StoreBlob(blob *kzg4844.Blob) (common.Hash, error) {
+	if s.endpoint != nil {
+		return common.Hash{}, errors.New("can not store blobs to a remote local source")
+	}
+
+	commitment, err := kzg4844.BlobToCommitment(*blob)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	blobHash := KZGToVersionedHash(commitment)
+	if err := os.WriteFile(
+		filepath.Join(s.dir, blobHash.Hex()),
+		[]byte(hexutil.Encode(blob[:])),
+		0o600,
+	); err != nil {
+		return common.Hash{}, err
+	}
+
+	return blobHash, nil
+}
+
+// read reads the hex encoded blob from the local directory.
+func (s *LocalSource) read(blobHash common.Hash) (string, error) {
+	b, err := os.ReadFile(filepath.Join(s.dir, blobHash.Hex()))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", ErrBlobNotFound
+		}
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// fetch fetches the hex encoded blob from the HTTP server.
+func (s *LocalSource) fetch(ctx context.Context, blobHash common.Hash) (string, error) {
+	requestURL, err := url.JoinPath(s.endpoint.String(), blobHash.Hex())
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.client.R().SetContext(ctx).Get(requestURL)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return "", ErrBlobNotFound
+	}
+	if !resp.IsSuccess() {
+		return "", fmt.Errorf("unsuccessful response %d", resp.StatusCode())
+	}
+
+	return resp.String(), nil
+}
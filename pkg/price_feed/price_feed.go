@@ -0,0 +1,87 @@
+package pricefeed
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// PriceFeed provides the prices of the ERC-20 tokens used to pay the prover fees.
+type PriceFeed interface {
+	// TokenPerETH returns the amount of the given token's base units which equals to 1 ETH.
+	TokenPerETH(ctx context.Context, token common.Address) (*big.Int, error)
+}
+
+// StaticPriceFeed is a PriceFeed implementation with statically configured prices.
+type StaticPriceFeed struct {
+	prices map[common.Address]*big.Int
+}
+
+// NewStaticPriceFeed creates a new StaticPriceFeed instance with the given prices.
+func NewStaticPriceFeed(prices map[common.Address]*big.Int) *StaticPriceFeed {
+	return &StaticPriceFeed{prices: prices}
+}
+
+// TokenPerETH implements the PriceFeed interface.
+func (f *StaticPriceFeed) TokenPerETH(_ context.Context, token common.Address) (*big.Int, error) {
+	price, ok := f.prices[token]
+	if !ok {
+		return nil, fmt.Errorf("no price for token %s", token.Hex())
+	}
+	return price, nil
+}
+
+// Tokens returns all tokens which have a configured price.
+func (f *StaticPriceFeed) Tokens() []common.Address {
+	tokens := make([]common.Address, 0, len(f.prices))
+	for token := range f.prices {
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// ParsePrices parses the comma separated `token:tokenPerETH` prices, e.g.
+// `0x...:2000000000` means 1 ETH equals to 2000000000 base units of the token.
+func ParsePrices(s string) (map[common.Address]*big.Int, error) {
+	prices := make(map[common.Address]*big.Int)
+	for _, item := range strings.Split(s, ",") {
+		token, price, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok || !common.IsHexAddress(token) {
+			return nil, fmt.Errorf("invalid token price: %s", item)
+		}
+
+		amount, ok := new(big.Int).SetString(price, 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid token price: %s", item)
+		}
+		prices[common.HexToAddress(token)] = amount
+	}
+
+	return prices, nil
+}
+
+// WeiToToken converts the given amount of wei to the given token's base units.
+func WeiToToken(ctx context.Context, feed PriceFeed, token common.Address, wei *big.Int) (*big.Int, error) {
+	price, err := feed.TokenPerETH(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := new(big.Int).Mul(wei, price)
+	return amount.Div(amount, big.NewInt(params.Ether)), nil
+}
+
+// TokenToWei converts the given amount of the token's base units to wei.
+func TokenToWei(ctx context.Context, feed PriceFeed, token common.Address, amount *big.Int) (*big.Int, error) {
+	price, err := feed.TokenPerETH(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	wei := new(big.Int).Mul(amount, big.NewInt(params.Ether))
+	return wei.Div(wei, price), nil
+}
@@ -0,0 +1,42 @@
+package pricefeed
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+var testToken = common.BigToAddress(common.Big1)
+
+func TestParsePrices(t *testing.T) {
+	prices, err := ParsePrices(testToken.Hex() + ":2000000000, " + common.BigToAddress(common.Big2).Hex() + ":1")
+	require.Nil(t, err)
+	require.Len(t, prices, 2)
+	require.Equal(t, big.NewInt(2000000000), prices[testToken])
+
+	for _, invalid := range []string{"", "0x:1", testToken.Hex(), testToken.Hex() + ":0", testToken.Hex() + ":abc"} {
+		_, err = ParsePrices(invalid)
+		require.ErrorContains(t, err, "invalid token price")
+	}
+}
+
+func TestConversion(t *testing.T) {
+	// 1 ETH = 2000 tokens with 6 decimals.
+	feed := NewStaticPriceFeed(map[common.Address]*big.Int{testToken: big.NewInt(2000_000000)})
+	require.Equal(t, []common.Address{testToken}, feed.Tokens())
+
+	amount, err := WeiToToken(context.Background(), feed, testToken, big.NewInt(params.Ether/100))
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(20_000000), amount)
+
+	wei, err := TokenToWei(context.Background(), feed, testToken, amount)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(params.Ether/100), wei)
+
+	_, err = WeiToToken(context.Background(), feed, common.Address{}, common.Big1)
+	require.ErrorContains(t, err, "no price for token")
+}
@@ -0,0 +1,242 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+const (
+	// blobBytesPerFieldElement is the number of data bytes carried by each blob field element, the
+	// first byte of every field element is always left empty to keep it below the BLS modulus.
+	blobBytesPerFieldElement = params.BlobTxBytesPerFieldElement - 1
+	// BlobDataSize is the maximum number of data bytes a single blob can carry.
+	BlobDataSize = params.BlobTxFieldElementsPerBlob * blobBytesPerFieldElement
+)
+
+var (
+	ErrBlobDataTooLarge = errors.New("data too large to fit in a blob")
+	// FallbackBlobGasFeeCap is the default blob gas fee cap used when the L1 head
+	// has no excess blob gas field, i.e. Cancun is not activated on L1 yet.
+	FallbackBlobGasFeeCap = big.NewInt(params.BlobTxMinBlobGasprice)
+)
+
+// EncodeBlob encodes the given bytes into a blob, every field element carries
+// `blobBytesPerFieldElement` bytes of data.
+func EncodeBlob(data []byte) (*kzg4844.Blob, error) {
+	if len(data) > BlobDataSize {
+		return nil, fmt.Errorf("%w: %d > %d", ErrBlobDataTooLarge, len(data), BlobDataSize)
+	}
+
+	var blob kzg4844.Blob
+	for i := 0; i*blobBytesPerFieldElement < len(data); i++ {
+		copy(
+			blob[i*params.BlobTxBytesPerFieldElement+1:(i+1)*params.BlobTxBytesPerFieldElement],
+			data[i*blobBytesPerFieldElement:],
+		)
+	}
+
+	return &blob, nil
+}
+
+// DecodeBlob decodes the given blob back to the `BlobDataSize` bytes it carries.
+func DecodeBlob(blob *kzg4844.Blob) []byte {
+	data := make([]byte, BlobDataSize)
+	for i := 0; i < params.BlobTxFieldElementsPerBlob; i++ {
+		copy(
+			data[i*blobBytesPerFieldElement:(i+1)*blobBytesPerFieldElement],
+			blob[i*params.BlobTxBytesPerFieldElement+1:(i+1)*params.BlobTxBytesPerFieldElement],
+		)
+	}
+
+	return data
+}
+
+// MakeSidecar creates a blob transaction sidecar with commitments and proofs for the given blobs.
+func MakeSidecar(blobs ...*kzg4844.Blob) (*types.BlobTxSidecar, error) {
+	sidecar := &types.BlobTxSidecar{}
+	for _, blob := range blobs {
+		commitment, err := kzg4844.BlobToCommitment(*blob)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute blob commitment: %w", err)
+		}
+
+		proof, err := kzg4844.ComputeBlobProof(*blob, commitment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute blob proof: %w", err)
+		}
+
+		sidecar.Blobs = append(sidecar.Blobs, *blob)
+		sidecar.Commitments = append(sidecar.Commitments, commitment)
+		sidecar.Proofs = append(sidecar.Proofs, proof)
+	}
+
+	return sidecar, nil
+}
+
+// TransactBlobTx builds, signs and sends an EIP-4844 blob-carrying transaction which
+// calls the given contract with the given input data.
+func (c *Client) TransactBlobTx(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	contract common.Address,
+	input []byte,
+	sidecar *types.BlobTxSidecar,
+) (*types.Transaction, error) {
+	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, defaultTimeout)
+	defer cancel()
+
+	head, err := c.L1.HeaderByNumber(ctxWithTimeout, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce uint64
+	if opts.Nonce != nil {
+		nonce = opts.Nonce.Uint64()
+	} else if nonce, err = c.L1.PendingNonceAt(ctxWithTimeout, opts.From); err != nil {
+		return nil, err
+	}
+
+	value := opts.Value
+	if value == nil {
+		value = common.Big0
+	}
+
+	gasTipCap := opts.GasTipCap
+	if gasTipCap == nil {
+		if gasTipCap, err = c.L1.SuggestGasTipCap(ctxWithTimeout); err != nil {
+			return nil, err
+		}
+	}
+
+	gasFeeCap := opts.GasFeeCap
+	if gasFeeCap == nil {
+		gasFeeCap = new(big.Int).Add(gasTipCap, new(big.Int).Mul(head.BaseFee, common.Big2))
+	}
+
+	blobFeeCap := blobGasFeeCap(head)
+
+	gasLimit := opts.GasLimit
+	if gasLimit == 0 {
+		if gasLimit, err = c.estimateBlobTxGas(
+			ctxWithTimeout,
+			opts.From,
+			contract,
+			value,
+			input,
+			sidecar.BlobHashes(),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	rawTx, err := opts.Signer(opts.From, types.NewTx(&types.BlobTx{
+		ChainID:    uint256.MustFromBig(c.L1ChainID),
+		Nonce:      nonce,
+		GasTipCap:  uint256.MustFromBig(gasTipCap),
+		GasFeeCap:  uint256.MustFromBig(gasFeeCap),
+		Gas:        gasLimit,
+		To:         contract,
+		Value:      uint256.MustFromBig(value),
+		Data:       input,
+		BlobFeeCap: uint256.MustFromBig(blobFeeCap),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.NoSend {
+		return rawTx, nil
+	}
+
+	if err := c.L1.SendTransaction(ctxWithTimeout, rawTx); err != nil {
+		return nil, err
+	}
+
+	return rawTx, nil
+}
+
+// CallBlobTx executes a blob-carrying message call through `eth_call` without creating a transaction, with
+// the same sender, value and gas limit as the given transaction options, since `ethereum.CallMsg` can't carry
+// blob versioned hashes.
+func (c *Client) CallBlobTx(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	contract common.Address,
+	input []byte,
+	sidecar *types.BlobTxSidecar,
+) error {
+	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, defaultTimeout)
+	defer cancel()
+
+	head, err := c.L1.HeaderByNumber(ctxWithTimeout, nil)
+	if err != nil {
+		return err
+	}
+
+	value := opts.Value
+	if value == nil {
+		value = common.Big0
+	}
+
+	args := map[string]interface{}{
+		"from":                opts.From,
+		"to":                  contract,
+		"value":               (*hexutil.Big)(value),
+		"input":               hexutil.Bytes(input),
+		"blobVersionedHashes": sidecar.BlobHashes(),
+		"maxFeePerBlobGas":    (*hexutil.Big)(blobGasFeeCap(head)),
+	}
+	if opts.GasLimit != 0 {
+		args["gas"] = hexutil.Uint64(opts.GasLimit)
+	}
+
+	var result hexutil.Bytes
+	return c.L1RawRPC.CallContext(ctxWithTimeout, &result, "eth_call", args, "latest")
+}
+
+// blobGasFeeCap returns the blob gas fee cap of a blob-carrying transaction, based on the given L1 head.
+func blobGasFeeCap(head *types.Header) *big.Int {
+	if head.ExcessBlobGas == nil {
+		return FallbackBlobGasFeeCap
+	}
+
+	return new(big.Int).Mul(eip4844.CalcBlobFee(*head.ExcessBlobGas), common.Big2)
+}
+
+// estimateBlobTxGas estimates the gas limit of a blob-carrying transaction, since `ethereum.CallMsg`
+// can not carry the blob versioned hashes, a raw `eth_estimateGas` RPC call is made here.
+func (c *Client) estimateBlobTxGas(
+	ctx context.Context,
+	from common.Address,
+	to common.Address,
+	value *big.Int,
+	input []byte,
+	blobHashes []common.Hash,
+) (uint64, error) {
+	var gas hexutil.Uint64
+	if err := c.L1RawRPC.CallContext(ctx, &gas, "eth_estimateGas", map[string]interface{}{
+		"from":                from,
+		"to":                  to,
+		"value":               (*hexutil.Big)(value),
+		"input":               hexutil.Bytes(input),
+		"blobVersionedHashes": blobHashes,
+	}); err != nil {
+		return 0, err
+	}
+
+	return uint64(gas), nil
+}
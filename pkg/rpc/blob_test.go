@@ -0,0 +1,43 @@
+package rpc
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeBlob(t *testing.T) {
+	data := make([]byte, BlobDataSize)
+	_, err := rand.Read(data)
+	require.Nil(t, err)
+
+	blob, err := EncodeBlob(data)
+	require.Nil(t, err)
+
+	for i := 0; i < len(blob); i += 32 {
+		require.Zero(t, blob[i])
+	}
+	require.Equal(t, data, DecodeBlob(blob))
+
+	blob, err = EncodeBlob([]byte{0x01, 0x02, 0x03})
+	require.Nil(t, err)
+	require.Equal(t, []byte{0x01, 0x02, 0x03}, DecodeBlob(blob)[:3])
+
+	_, err = EncodeBlob(make([]byte, BlobDataSize+1))
+	require.ErrorIs(t, err, ErrBlobDataTooLarge)
+}
+
+func TestMakeSidecar(t *testing.T) {
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	require.Nil(t, err)
+
+	blob, err := EncodeBlob(data)
+	require.Nil(t, err)
+
+	sidecar, err := MakeSidecar(blob)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(sidecar.Blobs))
+	require.Equal(t, 1, len(sidecar.BlobHashes()))
+}
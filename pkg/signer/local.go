@@ -0,0 +1,66 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// LocalSigner signs with a private key held in memory.
+type LocalSigner struct {
+	privKey *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewLocalSigner creates a new LocalSigner instance with the given private key.
+func NewLocalSigner(privKey *ecdsa.PrivateKey) *LocalSigner {
+	return &LocalSigner{privKey: privKey, address: crypto.PubkeyToAddress(privKey.PublicKey)}
+}
+
+// NewKeystoreSigner creates a new LocalSigner instance with the private key decrypted from the given
+// encrypted JSON keystore file, the password is read from the given password file.
+func NewKeystoreSigner(keystorePath string, passwordPath string) (*LocalSigner, error) {
+	keyJSON, err := os.ReadFile(keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore file: %w", err)
+	}
+
+	var password string
+	if passwordPath != "" {
+		b, err := os.ReadFile(passwordPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read keystore password file: %w", err)
+		}
+		password = strings.TrimRight(string(b), "\r\n")
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore file: %w", err)
+	}
+
+	return NewLocalSigner(key.PrivateKey), nil
+}
+
+// Address implements the Signer interface.
+func (s *LocalSigner) Address() common.Address {
+	return s.address
+}
+
+// SignTx implements the Signer interface.
+func (s *LocalSigner) SignTx(_ context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.privKey)
+}
+
+// SignData implements the Signer interface.
+func (s *LocalSigner) SignData(_ context.Context, data []byte) ([]byte, error) {
+	return crypto.Sign(crypto.Keccak256(data), s.privKey)
+}
@@ -0,0 +1,275 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	// Web3Signer's JSON-RPC method to sign a transaction.
+	web3SignerSignTxMethod = "eth_signTransaction"
+	// Clef's JSON-RPC method to sign a transaction.
+	clefSignTxMethod = "account_signTransaction"
+	// Clef's JSON-RPC method to get its version, which is not supported by Web3Signer.
+	clefVersionMethod = "account_version"
+	// JSON-RPC error code when the method is not supported by the remote signer.
+	methodNotFoundErrCode = -32601
+	remoteSignerTimeout   = 30 * time.Second
+)
+
+// sendTxArgs is the transaction arguments sent to the remote signer.
+type sendTxArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	Input                hexutil.Bytes   `json:"input"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+	MaxFeePerBlobGas     *hexutil.Big    `json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes  []common.Hash   `json:"blobVersionedHashes,omitempty"`
+}
+
+// RemoteSigner signs with a Web3Signer or Clef compatible remote signer through HTTP, transactions are signed
+// through the `eth_signTransaction` (Web3Signer) or `account_signTransaction` (Clef) JSON-RPC method,
+// and data is signed through the Web3Signer's `/api/v1/eth1/sign/{identifier}` REST endpoint, whose identifier
+// is the account's public key listed by the `/api/v1/eth1/publicKeys` REST endpoint. Clef always signs data
+// with an EIP-191 prefix, so it can't be used to sign data, see `IsClef`.
+type RemoteSigner struct {
+	url        string
+	address    common.Address
+	rpc        *rpc.Client
+	httpClient *http.Client
+	publicKey  string // Web3Signer's identifier of the account, fetched on the first data signing
+	mutex      sync.Mutex
+}
+
+// NewRemoteSigner creates a new RemoteSigner instance for the given account.
+func NewRemoteSigner(url string, address common.Address) (*RemoteSigner, error) {
+	client, err := rpc.DialHTTP(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer: %w", err)
+	}
+
+	return &RemoteSigner{
+		url:        strings.TrimSuffix(url, "/"),
+		address:    address,
+		rpc:        client,
+		httpClient: &http.Client{Timeout: remoteSignerTimeout},
+	}, nil
+}
+
+// Address implements the Signer interface.
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// SignTx implements the Signer interface.
+func (s *RemoteSigner) SignTx(
+	ctx context.Context,
+	tx *types.Transaction,
+	chainID *big.Int,
+) (*types.Transaction, error) {
+	if tx.Type() == types.LegacyTxType {
+		return nil, errors.New("legacy transactions are not supported by remote signer")
+	}
+
+	args := &sendTxArgs{
+		From:                 s.address,
+		To:                   tx.To(),
+		Gas:                  hexutil.Uint64(tx.Gas()),
+		MaxFeePerGas:         (*hexutil.Big)(tx.GasFeeCap()),
+		MaxPriorityFeePerGas: (*hexutil.Big)(tx.GasTipCap()),
+		Value:                (*hexutil.Big)(tx.Value()),
+		Nonce:                hexutil.Uint64(tx.Nonce()),
+		Data:                 tx.Data(),
+		Input:                tx.Data(),
+		ChainID:              (*hexutil.Big)(chainID),
+	}
+	if tx.Type() == types.BlobTxType {
+		args.MaxFeePerBlobGas = (*hexutil.Big)(tx.BlobGasFeeCap())
+		args.BlobVersionedHashes = tx.BlobHashes()
+	}
+
+	var result json.RawMessage
+	err := s.rpc.CallContext(ctx, &result, web3SignerSignTxMethod, args)
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFoundErrCode {
+		err = s.rpc.CallContext(ctx, &result, clefSignTxMethod, args)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction with remote signer: %w", err)
+	}
+
+	signed, err := decodeSignedTx(result)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the signature is taken from the remote signer, and applied to the original transaction, since the
+	// remote signer may not keep the blob sidecar.
+	v, r, sigS := signed.RawSignatureValues()
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	sigS.FillBytes(sig[32:64])
+	sig[64] = byte(v.Uint64())
+
+	return withSignature(tx, chainID, sig, s.address)
+}
+
+// IsClef returns whether the remote signer is Clef, which can only sign transactions.
+func (s *RemoteSigner) IsClef(ctx context.Context) bool {
+	var version string
+	return s.rpc.CallContext(ctx, &version, clefVersionMethod) == nil
+}
+
+// SignData implements the Signer interface.
+func (s *RemoteSigner) SignData(ctx context.Context, data []byte) ([]byte, error) {
+	publicKey, err := s.web3SignerPublicKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(map[string]string{"data": hexutil.Encode(data)})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/api/v1/eth1/sign/%s", s.url, publicKey),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign data with remote signer: %w", err)
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to sign data with remote signer, status: %d, body: %s", res.StatusCode, resBody)
+	}
+
+	sig, err := hexutil.Decode(strings.Trim(strings.TrimSpace(string(resBody)), `"`))
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer signature: %w", err)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid remote signer signature length: %d", len(sig))
+	}
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(data), sig)
+	if err != nil {
+		return nil, err
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != s.address {
+		return nil, fmt.Errorf("unexpected data signer: %s, expected: %s", signer, s.address)
+	}
+
+	return sig, nil
+}
+
+// web3SignerPublicKey returns the public key of the account, which is Web3Signer's identifier of the account
+// in the data signing REST endpoint, through all public keys listed by the remote signer.
+func (s *RemoteSigner) web3SignerPublicKey(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.publicKey != "" {
+		return s.publicKey, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url+"/api/v1/eth1/publicKeys", nil)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to list remote signer public keys: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to list remote signer public keys, status: %d", res.StatusCode)
+	}
+
+	var publicKeys []string
+	if err := json.NewDecoder(res.Body).Decode(&publicKeys); err != nil {
+		return "", fmt.Errorf("invalid remote signer public keys: %w", err)
+	}
+
+	for _, publicKey := range publicKeys {
+		b, err := hexutil.Decode(publicKey)
+		if err != nil {
+			continue
+		}
+		// Web3Signer lists the uncompressed public keys without the 0x04 prefix.
+		if len(b) == 64 {
+			b = append([]byte{4}, b...)
+		}
+		pubKey, err := crypto.UnmarshalPubkey(b)
+		if err != nil {
+			continue
+		}
+		if crypto.PubkeyToAddress(*pubKey) == s.address {
+			s.publicKey = publicKey
+			return publicKey, nil
+		}
+	}
+
+	return "", fmt.Errorf("no public key of account %s found in remote signer", s.address)
+}
+
+// decodeSignedTx decodes the signed transaction returned by the remote signer, which is either the raw
+// transaction (Web3Signer), or an object with a `raw` field (Clef).
+func decodeSignedTx(result json.RawMessage) (*types.Transaction, error) {
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		var clefResult struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err := json.Unmarshal(result, &clefResult); err != nil {
+			return nil, fmt.Errorf("invalid remote signer result: %w", err)
+		}
+		raw = clefResult.Raw
+	}
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("invalid signed transaction from remote signer: %w", err)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,99 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Signer signs L1 transactions and data on behalf of an account, without exposing the account's private key
+// to the callers.
+type Signer interface {
+	// Address returns the address of the signing account.
+	Address() common.Address
+	// SignTx signs the given transaction with the given chain ID.
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// SignData signs the keccak256 hash of the given data, without any prefix, the returned signature is in
+	// the [R || S || V] format, where V is 0 or 1.
+	SignData(ctx context.Context, data []byte) ([]byte, error)
+}
+
+// Config contains the configurations to create a Signer, exactly one of the private key, the keystore file and
+// the remote signer URL should be set.
+type Config struct {
+	PrivateKey    *ecdsa.PrivateKey
+	KeystorePath  string
+	PasswordPath  string
+	RemoteURL     string
+	RemoteAddress common.Address
+}
+
+// New creates a new Signer instance based on the given configurations.
+func New(cfg *Config) (Signer, error) {
+	var sources int
+	for _, set := range []bool{cfg.PrivateKey != nil, cfg.KeystorePath != "", cfg.RemoteURL != ""} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return nil, errors.New("exactly one of private key, keystore file and remote signer should be set")
+	}
+
+	switch {
+	case cfg.PrivateKey != nil:
+		return NewLocalSigner(cfg.PrivateKey), nil
+	case cfg.KeystorePath != "":
+		return NewKeystoreSigner(cfg.KeystorePath, cfg.PasswordPath)
+	default:
+		if cfg.RemoteAddress == (common.Address{}) {
+			return nil, errors.New("empty remote signer account address")
+		}
+		return NewRemoteSigner(cfg.RemoteURL, cfg.RemoteAddress)
+	}
+}
+
+// NewTransactOpts creates a bind.TransactOpts instance which signs transactions with the given signer.
+func NewTransactOpts(ctx context.Context, s Signer, chainID *big.Int) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From: s.Address(),
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != s.Address() {
+				return nil, bind.ErrNotAuthorized
+			}
+			return s.SignTx(ctx, tx, chainID)
+		},
+		Context: ctx,
+	}
+}
+
+// withSignature applies the given [R || S || V] signature to the given transaction, and checks whether
+// the transaction is signed by the expected account.
+func withSignature(
+	tx *types.Transaction,
+	chainID *big.Int,
+	sig []byte,
+	expected common.Address,
+) (*types.Transaction, error) {
+	signer := types.LatestSignerForChainID(chainID)
+	signed, err := tx.WithSignature(signer, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, err
+	}
+	if sender != expected {
+		return nil, fmt.Errorf("unexpected transaction signer: %s, expected: %s", sender, expected)
+	}
+
+	return signed, nil
+}
@@ -0,0 +1,218 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var testChainID = big.NewInt(1)
+
+func newTestTx() *types.Transaction {
+	to := common.BigToAddress(common.Big1)
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     1,
+		GasTipCap: common.Big1,
+		GasFeeCap: common.Big2,
+		Gas:       21000,
+		To:        &to,
+		Value:     common.Big0,
+		Data:      []byte{0x01},
+	})
+}
+
+// newStubRemoteSigner starts a stub Web3Signer / Clef compatible remote signer server.
+func newStubRemoteSigner(t *testing.T, key *ecdsa.PrivateKey, signTxMethod string) *httptest.Server {
+	otherKey, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	// Web3Signer identifies the accounts by their uncompressed public keys without the 0x04 prefix.
+	publicKey := func(key *ecdsa.PrivateKey) string {
+		return hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey)[1:])
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/api/v1/eth1/publicKeys" {
+			w.Header().Set("Content-Type", "application/json")
+			require.Nil(t, json.NewEncoder(w).Encode([]string{publicKey(otherKey), publicKey(key)}))
+			return
+		}
+
+		if strings.HasPrefix(r.URL.Path, "/api/v1/eth1/sign/") {
+			if r.Method != http.MethodPost || r.URL.Path != "/api/v1/eth1/sign/"+publicKey(key) {
+				http.NotFound(w, r)
+				return
+			}
+
+			var req struct {
+				Data hexutil.Bytes `json:"data"`
+			}
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+
+			sig, err := crypto.Sign(crypto.Keccak256(req.Data), key)
+			require.Nil(t, err)
+			sig[64] += 27
+			_, _ = w.Write([]byte(hexutil.Encode(sig)))
+			return
+		}
+
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []*sendTxArgs   `json:"params"`
+		}
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+
+		res := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if req.Method == clefVersionMethod && signTxMethod == clefSignTxMethod {
+			res["result"] = "6.0.0"
+		} else if req.Method != signTxMethod {
+			res["error"] = map[string]interface{}{"code": methodNotFoundErrCode, "message": "method not found"}
+		} else {
+			args := req.Params[0]
+			tx, err := types.SignNewTx(key, types.LatestSignerForChainID(args.ChainID.ToInt()), &types.DynamicFeeTx{
+				ChainID:   args.ChainID.ToInt(),
+				Nonce:     uint64(args.Nonce),
+				GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+				GasFeeCap: args.MaxFeePerGas.ToInt(),
+				Gas:       uint64(args.Gas),
+				To:        args.To,
+				Value:     args.Value.ToInt(),
+				Data:      args.Data,
+			})
+			require.Nil(t, err)
+			raw, err := tx.MarshalBinary()
+			require.Nil(t, err)
+
+			if signTxMethod == clefSignTxMethod {
+				res["result"] = map[string]interface{}{"raw": hexutil.Encode(raw)}
+			} else {
+				res["result"] = hexutil.Encode(raw)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.Nil(t, json.NewEncoder(w).Encode(res))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func testSigner(t *testing.T, s Signer, address common.Address) {
+	require.Equal(t, address, s.Address())
+
+	signed, err := s.SignTx(context.Background(), newTestTx(), testChainID)
+	require.Nil(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+	require.Nil(t, err)
+	require.Equal(t, address, sender)
+
+	data := []byte("test data")
+	sig, err := s.SignData(context.Background(), data)
+	require.Nil(t, err)
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(data), sig)
+	require.Nil(t, err)
+	require.Equal(t, address, crypto.PubkeyToAddress(*pubKey))
+
+	opts := NewTransactOpts(context.Background(), s, testChainID)
+	require.Equal(t, address, opts.From)
+	_, err = opts.Signer(common.Address{}, newTestTx())
+	require.NotNil(t, err)
+}
+
+func TestLocalSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	testSigner(t, NewLocalSigner(key), crypto.PubkeyToAddress(key.PublicKey))
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	keyJSON, err := keystore.EncryptKey(
+		&keystore.Key{
+			Id:         uuid.New(),
+			Address:    crypto.PubkeyToAddress(key.PublicKey),
+			PrivateKey: key,
+		},
+		"password",
+		keystore.LightScryptN,
+		keystore.LightScryptP,
+	)
+	require.Nil(t, err)
+
+	var (
+		dir          = t.TempDir()
+		keystorePath = filepath.Join(dir, "keystore.json")
+		passwordPath = filepath.Join(dir, "password")
+	)
+	require.Nil(t, os.WriteFile(keystorePath, keyJSON, 0600))
+	require.Nil(t, os.WriteFile(passwordPath, []byte("password\n"), 0600))
+
+	s, err := New(&Config{KeystorePath: keystorePath, PasswordPath: passwordPath})
+	require.Nil(t, err)
+	testSigner(t, s, crypto.PubkeyToAddress(key.PublicKey))
+
+	require.Nil(t, os.WriteFile(passwordPath, []byte("wrong"), 0600))
+	_, err = NewKeystoreSigner(keystorePath, passwordPath)
+	require.ErrorContains(t, err, "failed to decrypt keystore file")
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	for _, method := range []string{web3SignerSignTxMethod, clefSignTxMethod} {
+		t.Run(method, func(t *testing.T) {
+			srv := newStubRemoteSigner(t, key, method)
+
+			s, err := New(&Config{RemoteURL: srv.URL, RemoteAddress: address})
+			require.Nil(t, err)
+			testSigner(t, s, address)
+
+			remote, err := NewRemoteSigner(srv.URL, address)
+			require.Nil(t, err)
+			require.Equal(t, method == clefSignTxMethod, remote.IsClef(context.Background()))
+
+			// The remote signer signs with another account.
+			s, err = NewRemoteSigner(srv.URL, common.BigToAddress(common.Big1))
+			require.Nil(t, err)
+			_, err = s.SignTx(context.Background(), newTestTx(), testChainID)
+			require.ErrorContains(t, err, "unexpected transaction signer")
+			_, err = s.SignData(context.Background(), []byte("test data"))
+			require.ErrorContains(t, err, "no public key of account")
+		})
+	}
+}
+
+func TestNewSignerConfigErr(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	_, err = New(&Config{})
+	require.NotNil(t, err)
+	_, err = New(&Config{PrivateKey: key, KeystorePath: "keystore.json"})
+	require.NotNil(t, err)
+	_, err = New(&Config{RemoteURL: "http://localhost:9000"})
+	require.ErrorContains(t, err, "empty remote signer account address")
+}
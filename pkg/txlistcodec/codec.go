@@ -0,0 +1,134 @@
+package txlistcodec
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/andybalholm/brotli"
+)
+
+// Names of all supported codecs.
+const (
+	None   = "none"
+	Zlib   = "zlib"
+	Brotli = "brotli"
+)
+
+var (
+	// ErrDecodedTooLarge is returned when a decoded transactions list exceeds maxDecodedSize.
+	ErrDecodedTooLarge = errors.New("decoded transactions list too large")
+	// maxDecodedSize limits the size of a decoded transactions list, to protect the decoder
+	// against decompression bombs.
+	maxDecodedSize = 16 * 1024 * 1024
+)
+
+// Codec encodes a RLP encoded transactions list before it is proposed, and decodes the proposed bytes
+// back to the RLP encoded transactions list when deriving L2 blocks. The proposer and the driver must
+// use the same codec.
+type Codec interface {
+	// Name returns the codec's name.
+	Name() string
+	// Encode encodes the given RLP encoded transactions list.
+	Encode(txListBytes []byte) ([]byte, error)
+	// Decode decodes the given proposed bytes back to the RLP encoded transactions list.
+	Decode(data []byte) ([]byte, error)
+}
+
+// New creates a new codec instance based on the given name, an empty name means no encoding.
+func New(name string) (Codec, error) {
+	switch name {
+	case "", None:
+		return &noneCodec{}, nil
+	case Zlib:
+		return &zlibCodec{}, nil
+	case Brotli:
+		return &brotliCodec{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported transactions list codec: %s", name)
+	}
+}
+
+// noneCodec proposes RLP encoded transactions lists as they are.
+type noneCodec struct{}
+
+// Name implements the Codec interface.
+func (c *noneCodec) Name() string { return None }
+
+// Encode implements the Codec interface.
+func (c *noneCodec) Encode(txListBytes []byte) ([]byte, error) { return txListBytes, nil }
+
+// Decode implements the Codec interface.
+func (c *noneCodec) Decode(data []byte) ([]byte, error) { return data, nil }
+
+// zlibCodec compresses RLP encoded transactions lists with zlib.
+type zlibCodec struct{}
+
+// Name implements the Codec interface.
+func (c *zlibCodec) Name() string { return Zlib }
+
+// Encode implements the Codec interface.
+func (c *zlibCodec) Encode(txListBytes []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := zlib.NewWriterLevel(&buf, zlib.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	return compress(&buf, w, txListBytes)
+}
+
+// Decode implements the Codec interface.
+func (c *zlibCodec) Decode(data []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return decompress(r)
+}
+
+// brotliCodec compresses RLP encoded transactions lists with brotli.
+type brotliCodec struct{}
+
+// Name implements the Codec interface.
+func (c *brotliCodec) Name() string { return Brotli }
+
+// Encode implements the Codec interface.
+func (c *brotliCodec) Encode(txListBytes []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	return compress(&buf, brotli.NewWriterLevel(&buf, brotli.BestCompression), txListBytes)
+}
+
+// Decode implements the Codec interface.
+func (c *brotliCodec) Decode(data []byte) ([]byte, error) {
+	return decompress(brotli.NewReader(bytes.NewReader(data)))
+}
+
+// compress writes the given data through the compressing writer, and returns the compressed bytes.
+func compress(buf *bytes.Buffer, w io.WriteCloser, data []byte) ([]byte, error) {
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decompress reads all decompressed bytes from the given reader, at most maxDecodedSize bytes.
+func decompress(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, int64(maxDecodedSize)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxDecodedSize {
+		return nil, ErrDecodedTooLarge
+	}
+
+	return data, nil
+}
@@ -0,0 +1,69 @@
+package txlistcodec
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	for _, name := range []string{None, Zlib, Brotli} {
+		c, err := New(name)
+		require.Nil(t, err)
+		require.Equal(t, name, c.Name())
+	}
+
+	c, err := New("")
+	require.Nil(t, err)
+	require.Equal(t, None, c.Name())
+
+	_, err = New("gzip")
+	require.NotNil(t, err)
+}
+
+func TestEncodeDecode(t *testing.T) {
+	random := make([]byte, 1024)
+	_, err := rand.Read(random)
+	require.Nil(t, err)
+
+	repetitive := bytes.Repeat([]byte{0x01, 0x02, 0x03, 0x04}, 1024)
+
+	for _, name := range []string{None, Zlib, Brotli} {
+		c, err := New(name)
+		require.Nil(t, err)
+
+		for _, data := range [][]byte{random, repetitive, {}} {
+			encoded, err := c.Encode(data)
+			require.Nil(t, err)
+
+			decoded, err := c.Decode(encoded)
+			require.Nil(t, err)
+			require.Equal(t, len(data), len(decoded))
+			require.True(t, bytes.Equal(data, decoded))
+		}
+
+		if name != None {
+			encoded, err := c.Encode(repetitive)
+			require.Nil(t, err)
+			require.Less(t, len(encoded), len(repetitive))
+
+			_, err = c.Decode(bytes.Repeat([]byte{0xff}, 64))
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestDecodeTooLarge(t *testing.T) {
+	for _, name := range []string{Zlib, Brotli} {
+		c, err := New(name)
+		require.Nil(t, err)
+
+		encoded, err := c.Encode(make([]byte, maxDecodedSize+1))
+		require.Nil(t, err)
+
+		_, err = c.Decode(encoded)
+		require.ErrorIs(t, err, ErrDecodedTooLarge)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/txlistcodec"
 )
 
 // InvalidTxListReason represents a reason why a transactions list is invalid.
@@ -23,25 +24,34 @@ type TxListValidator struct {
 	maxTransactionsPerBlock uint64
 	maxBytesPerTxList       uint64
 	chainID                 *big.Int
+	codec                   txlistcodec.Codec
 }
 
-// NewTxListValidator creates a new TxListValidator instance based on giving configurations.
+// NewTxListValidator creates a new TxListValidator instance based on giving configurations, the given codec
+// is used to decode the proposed transactions lists, if it's nil, the transactions lists are seen as
+// not encoded.
 func NewTxListValidator(
 	blockMaxGasLimit uint64,
 	maxTransactionsPerBlock uint64,
 	maxBytesPerTxList uint64,
 	chainID *big.Int,
+	codec txlistcodec.Codec,
 ) *TxListValidator {
+	if codec == nil {
+		codec, _ = txlistcodec.New(txlistcodec.None)
+	}
+
 	return &TxListValidator{
 		maxTransactionsPerBlock: maxTransactionsPerBlock,
 		blockMaxGasLimit:        blockMaxGasLimit,
 		maxBytesPerTxList:       maxBytesPerTxList,
 		chainID:                 chainID,
+		codec:                   codec,
 	}
 }
 
 // ValidateTxList checks whether the transactions list in the TaikoL1.proposeBlock transaction's
-// input data is valid.
+// input data is valid, and returns the decoded RLP encoded transactions list.
 func (v *TxListValidator) ValidateTxList(
 	blockID *big.Int,
 	proposeBlockTxInput []byte,
@@ -50,35 +60,55 @@ func (v *TxListValidator) ValidateTxList(
 		return nil, HintNone, 0, err
 	}
 
+	txListBytes, hint, txIdx = v.ValidateTxListBytes(blockID, txListBytes)
+
+	return txListBytes, hint, txIdx, nil
+}
+
+// ValidateTxListBytes checks whether the given proposed transactions list bytes are valid, and returns the
+// decoded RLP encoded transactions list. It should be used when the transactions list is not carried by the
+// TaikoL1.proposeBlock transaction's input data, i.e. when the transactions list is carried by a blob.
+func (v *TxListValidator) ValidateTxListBytes(
+	blockID *big.Int,
+	txListBytes []byte,
+) (decoded []byte, hint InvalidTxListReason, txIdx int) {
 	if len(txListBytes) == 0 {
-		return txListBytes, HintOK, 0, nil
+		return txListBytes, HintOK, 0
 	}
 
-	hint, txIdx = v.isTxListValid(blockID, txListBytes)
-
-	return txListBytes, hint, txIdx, nil
+	return v.isTxListValid(blockID, txListBytes)
 }
 
-// isTxListValid checks whether the transaction list is valid.
-func (v *TxListValidator) isTxListValid(blockID *big.Int, txListBytes []byte) (hint InvalidTxListReason, txIdx int) {
+// isTxListValid checks whether the transaction list is valid, the size limit is checked against the
+// proposed bytes, i.e. before decoding.
+func (v *TxListValidator) isTxListValid(
+	blockID *big.Int,
+	txListBytes []byte,
+) (decoded []byte, hint InvalidTxListReason, txIdx int) {
 	if len(txListBytes) > int(v.maxBytesPerTxList) {
 		log.Info("Transactions list binary too large", "length", len(txListBytes), "blockID", blockID)
-		return HintNone, 0
+		return nil, HintNone, 0
+	}
+
+	decoded, err := v.codec.Decode(txListBytes)
+	if err != nil {
+		log.Info("Failed to decode transactions list bytes", "blockID", blockID, "codec", v.codec.Name(), "error", err)
+		return nil, HintNone, 0
 	}
 
 	var txs types.Transactions
-	if err := rlp.DecodeBytes(txListBytes, &txs); err != nil {
+	if err := rlp.DecodeBytes(decoded, &txs); err != nil {
 		log.Info("Failed to decode transactions list bytes", "blockID", blockID, "error", err)
-		return HintNone, 0
+		return nil, HintNone, 0
 	}
 
 	log.Debug("Transactions list decoded", "blockID", blockID, "length", len(txs))
 
 	if txs.Len() > int(v.maxTransactionsPerBlock) {
 		log.Info("Too many transactions", "blockID", blockID, "count", txs.Len())
-		return HintNone, 0
+		return nil, HintNone, 0
 	}
 
 	log.Info("Transaction list is valid", "blockID", blockID)
-	return HintOK, 0
+	return decoded, HintOK, 0
 }
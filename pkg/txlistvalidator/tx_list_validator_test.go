@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/pkg/txlistcodec"
 )
 
 var (
@@ -37,6 +38,7 @@ func TestValidateTxList(t *testing.T) {
 		maxBlockNumTxs,
 		maxTxlistBytes,
 		chainID,
+		nil,
 	)
 
 	// Binary is not unpackable
@@ -45,12 +47,63 @@ func TestValidateTxList(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestValidateTxListBytes(t *testing.T) {
+	v := NewTxListValidator(
+		maxBlocksGasLimit,
+		maxBlockNumTxs,
+		maxTxlistBytes,
+		chainID,
+		nil,
+	)
+
+	decoded, hint, txIdx := v.ValidateTxListBytes(common.Big0, []byte{})
+	require.Equal(t, HintOK, hint)
+	require.Zero(t, txIdx)
+	require.Empty(t, decoded)
+
+	_, hint, _ = v.ValidateTxListBytes(common.Big0, randBytes(5))
+	require.Equal(t, HintNone, hint)
+
+	txListBytes := rlpEncodedTransactionBytes(1, true)
+	decoded, hint, _ = v.ValidateTxListBytes(common.Big0, txListBytes)
+	require.Equal(t, HintOK, hint)
+	require.Equal(t, txListBytes, decoded)
+}
+
+func TestValidateTxListBytesWithCodec(t *testing.T) {
+	for _, name := range []string{txlistcodec.Zlib, txlistcodec.Brotli} {
+		codec, err := txlistcodec.New(name)
+		require.Nil(t, err)
+
+		v := NewTxListValidator(
+			maxBlocksGasLimit,
+			maxBlockNumTxs,
+			maxTxlistBytes,
+			chainID,
+			codec,
+		)
+
+		txListBytes := rlpEncodedTransactionBytes(int(maxBlockNumTxs), true)
+		encoded, err := codec.Encode(txListBytes)
+		require.Nil(t, err)
+
+		decoded, hint, _ := v.ValidateTxListBytes(common.Big0, encoded)
+		require.Equal(t, HintOK, hint)
+		require.Equal(t, txListBytes, decoded)
+
+		// Not encoded by the codec.
+		_, hint, _ = v.ValidateTxListBytes(common.Big0, txListBytes)
+		require.Equal(t, HintNone, hint)
+	}
+}
+
 func TestIsTxListValid(t *testing.T) {
 	v := NewTxListValidator(
 		maxBlocksGasLimit,
 		maxBlockNumTxs,
 		maxTxlistBytes,
 		chainID,
+		nil,
 	)
 	tests := []struct {
 		name        string
@@ -98,7 +151,7 @@ func TestIsTxListValid(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			reason, txIdx := v.isTxListValid(tt.blockID, tt.txListBytes)
+			_, reason, txIdx := v.isTxListValid(tt.blockID, tt.txListBytes)
 			require.Equal(t, tt.wantReason, reason)
 			require.Equal(t, tt.wantTxIdx, txIdx)
 		})
@@ -0,0 +1,190 @@
+package proposer
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/metrics"
+)
+
+// intervalDecision is the reason why the adaptive proposing interval changed.
+type intervalDecision uint8
+
+// All adaptive proposing interval decisions, exposed by the `proposer/interval/decision` metric.
+const (
+	intervalKept intervalDecision = iota
+	intervalShortenedPoolPressure
+	intervalLengthenedL1BaseFeeSpike
+	intervalLengthenedSmallTxLists
+)
+
+// String implements the fmt.Stringer interface.
+func (d intervalDecision) String() string {
+	switch d {
+	case intervalShortenedPoolPressure:
+		return "shortenedPoolPressure"
+	case intervalLengthenedL1BaseFeeSpike:
+		return "lengthenedL1BaseFeeSpike"
+	case intervalLengthenedSmallTxLists:
+		return "lengthenedSmallTxLists"
+	default:
+		return "kept"
+	}
+}
+
+var (
+	// A transactions list is seen as full when it reaches this percentage of the protocol's limits.
+	fullTxListPercentage = uint64(90)
+	// Transactions lists are seen as small when all of them together are below this percentage of
+	// one transactions list's byte limit.
+	smallTxListsPercentage = uint64(25)
+	// Weight of the latest L1 base fee in the L1 base fee moving average.
+	l1BaseFeeAverageWeight = int64(8)
+)
+
+// poolObservation is what the proposer observed in the L2 transaction pool and L1 in a proposing epoch.
+type poolObservation struct {
+	txLists     []types.Transactions
+	maxBytes    uint64
+	maxGasLimit uint64
+	l1BaseFee   *big.Int
+}
+
+// adaptiveInterval adjusts the proposing interval between the given bounds, it shortens the interval
+// when the transaction pool is under pressure, and lengthens it when L1 base fee spikes or only small
+// transactions lists are available.
+type adaptiveInterval struct {
+	min                      time.Duration
+	max                      time.Duration
+	l1BaseFeeSpikePercentage uint64
+
+	mutex            sync.Mutex
+	current          time.Duration
+	lastPendingBytes uint64
+	l1BaseFeeAverage *big.Int
+}
+
+// newAdaptiveInterval creates a new adaptiveInterval instance, starting at the given interval.
+func newAdaptiveInterval(
+	initial time.Duration,
+	min time.Duration,
+	max time.Duration,
+	l1BaseFeeSpikePercentage uint64,
+) *adaptiveInterval {
+	if initial < min {
+		initial = min
+	}
+	if initial > max {
+		initial = max
+	}
+
+	metrics.ProposerIntervalGauge.Update(int64(initial.Seconds()))
+
+	return &adaptiveInterval{
+		min:                      min,
+		max:                      max,
+		l1BaseFeeSpikePercentage: l1BaseFeeSpikePercentage,
+		current:                  initial,
+	}
+}
+
+// Current returns the current proposing interval.
+func (a *adaptiveInterval) Current() time.Duration {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.current
+}
+
+// Observe updates the proposing interval based on the given observation, and returns the decision.
+func (a *adaptiveInterval) Observe(o *poolObservation) intervalDecision {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	var (
+		fullTxLists  int
+		pendingBytes uint64
+	)
+	for _, txs := range o.txLists {
+		var size, gasLimit uint64
+		for _, tx := range txs {
+			size += tx.Size()
+			gasLimit += tx.Gas()
+		}
+		if size*100 >= o.maxBytes*fullTxListPercentage || gasLimit*100 >= o.maxGasLimit*fullTxListPercentage {
+			fullTxLists++
+		}
+		pendingBytes += size
+	}
+
+	spiked := a.isL1BaseFeeSpike(o.l1BaseFee)
+
+	var decision intervalDecision
+	switch {
+	case spiked:
+		decision = intervalLengthenedL1BaseFeeSpike
+		a.current *= 2
+	case fullTxLists > 0 || (a.lastPendingBytes != 0 && pendingBytes > a.lastPendingBytes):
+		decision = intervalShortenedPoolPressure
+		a.current /= 2
+	case pendingBytes*100 < o.maxBytes*smallTxListsPercentage:
+		decision = intervalLengthenedSmallTxLists
+		a.current = a.current * 3 / 2
+	default:
+		decision = intervalKept
+	}
+
+	if a.current < a.min {
+		a.current = a.min
+	}
+	if a.current > a.max {
+		a.current = a.max
+	}
+	a.lastPendingBytes = pendingBytes
+
+	metrics.ProposerIntervalGauge.Update(int64(a.current.Seconds()))
+	metrics.ProposerIntervalDecisionGauge.Update(int64(decision))
+	metrics.ProposerPendingBytesGauge.Update(int64(pendingBytes))
+	metrics.ProposerFullTxListsGauge.Update(int64(fullTxLists))
+
+	log.Info(
+		"Adaptive proposing interval updated",
+		"interval", a.current,
+		"decision", decision,
+		"txLists", len(o.txLists),
+		"fullTxLists", fullTxLists,
+		"pendingBytes", pendingBytes,
+		"l1BaseFee", o.l1BaseFee,
+		"l1BaseFeeAverage", a.l1BaseFeeAverage,
+	)
+
+	return decision
+}
+
+// isL1BaseFeeSpike checks whether the given L1 base fee is above the moving average by more than
+// the configured percentage, and then updates the moving average.
+func (a *adaptiveInterval) isL1BaseFeeSpike(l1BaseFee *big.Int) bool {
+	if l1BaseFee == nil {
+		return false
+	}
+
+	if a.l1BaseFeeAverage == nil {
+		a.l1BaseFeeAverage = new(big.Int).Set(l1BaseFee)
+		return false
+	}
+
+	spiked := new(big.Int).Mul(l1BaseFee, big.NewInt(100)).Cmp(
+		new(big.Int).Mul(a.l1BaseFeeAverage, new(big.Int).SetUint64(a.l1BaseFeeSpikePercentage)),
+	) > 0
+
+	// average = (average * (weight - 1) + latest) / weight
+	a.l1BaseFeeAverage = new(big.Int).Div(
+		new(big.Int).Add(new(big.Int).Mul(a.l1BaseFeeAverage, big.NewInt(l1BaseFeeAverageWeight-1)), l1BaseFee),
+		big.NewInt(l1BaseFeeAverageWeight),
+	)
+
+	return spiked
+}
@@ -0,0 +1,62 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveInterval(t *testing.T) {
+	var (
+		a           = newAdaptiveInterval(time.Minute, 10*time.Second, 2*time.Minute, 150)
+		maxBytes    = uint64(4096)
+		maxGasLimit = uint64(21000 * 100)
+		observe     = func(l1BaseFee int64, txLists ...types.Transactions) intervalDecision {
+			return a.Observe(&poolObservation{
+				txLists:     txLists,
+				maxBytes:    maxBytes,
+				maxGasLimit: maxGasLimit,
+				l1BaseFee:   big.NewInt(l1BaseFee),
+			})
+		}
+	)
+	require.Equal(t, time.Minute, a.Current())
+
+	// Small transactions lists.
+	require.Equal(t, intervalLengthenedSmallTxLists, observe(100, newTestTxList(1, 0)))
+	require.Equal(t, 90*time.Second, a.Current())
+
+	// No transactions at all.
+	require.Equal(t, intervalLengthenedSmallTxLists, observe(100))
+	require.Equal(t, 2*time.Minute, a.Current())
+
+	// Full transactions lists.
+	require.Equal(t, intervalShortenedPoolPressure, observe(100, newTestTxList(1, 4000)))
+	require.Equal(t, time.Minute, a.Current())
+
+	// Pending bytes grow.
+	require.Equal(t, intervalShortenedPoolPressure, observe(100, newTestTxList(1, 2000), newTestTxList(1, 2000)))
+	require.Equal(t, 30*time.Second, a.Current())
+
+	// Pending bytes stay, neither small nor full.
+	require.Equal(t, intervalKept, observe(100, newTestTxList(1, 2000), newTestTxList(1, 2000)))
+	require.Equal(t, 30*time.Second, a.Current())
+
+	// L1 base fee spikes, even when the pool is under pressure.
+	require.Equal(t, intervalLengthenedL1BaseFeeSpike, observe(200, newTestTxList(1, 4000)))
+	require.Equal(t, time.Minute, a.Current())
+
+	// Bounded by the minimum interval.
+	for i := 0; i < 10; i++ {
+		observe(100, newTestTxList(1, 4000))
+	}
+	require.Equal(t, 10*time.Second, a.Current())
+}
+
+func TestNewAdaptiveIntervalBounds(t *testing.T) {
+	require.Equal(t, time.Second, newAdaptiveInterval(0, time.Second, time.Minute, 150).Current())
+	require.Equal(t, time.Minute, newAdaptiveInterval(time.Hour, time.Second, time.Minute, 150).Current())
+}
@@ -0,0 +1,147 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"golang.org/x/sync/errgroup"
+)
+
+// blobTxList locates a transactions list inside a blob.
+type blobTxList struct {
+	// Sidecar of the blob carrying the transactions list, should be nil when
+	// reusing a blob which has already been cached by the protocol.
+	sidecar  *types.BlobTxSidecar
+	blobHash common.Hash
+	offset   uint64
+	size     uint64
+	// Whether to ask the protocol to cache the blob for reuse.
+	cacheForReuse bool
+}
+
+// sharedBlob is a blob carrying several transactions lists at different offsets.
+type sharedBlob struct {
+	txLists []types.Transactions
+	data    []byte
+	offsets []uint64
+	sizes   []uint64
+}
+
+// newBlobTxList creates a new blobTxList instance, with the given transactions list as the
+// only content of a new blob.
+func newBlobTxList(txListBytes []byte, cacheForReuse bool) (*blobTxList, error) {
+	blob, err := rpc.EncodeBlob(txListBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	sidecar, err := rpc.MakeSidecar(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blobTxList{
+		sidecar:       sidecar,
+		blobHash:      sidecar.BlobHashes()[0],
+		offset:        0,
+		size:          uint64(len(txListBytes)),
+		cacheForReuse: cacheForReuse,
+	}, nil
+}
+
+// packTxListsIntoBlobs packs the given encoded transactions lists into as few blobs as possible,
+// keeping their original order.
+func packTxListsIntoBlobs(txLists []*encodedTxList) ([]*sharedBlob, error) {
+	var (
+		blobs   []*sharedBlob
+		current = &sharedBlob{}
+	)
+	for _, txList := range txLists {
+		txListBytes := txList.bytes
+		if len(txListBytes) > rpc.BlobDataSize {
+			return nil, fmt.Errorf("%w: %d", rpc.ErrBlobDataTooLarge, len(txListBytes))
+		}
+
+		if len(current.data)+len(txListBytes) > rpc.BlobDataSize {
+			blobs = append(blobs, current)
+			current = &sharedBlob{}
+		}
+
+		current.txLists = append(current.txLists, txList.txs)
+		current.offsets = append(current.offsets, uint64(len(current.data)))
+		current.sizes = append(current.sizes, uint64(len(txListBytes)))
+		current.data = append(current.data, txListBytes...)
+	}
+
+	if len(current.txLists) != 0 {
+		blobs = append(blobs, current)
+	}
+
+	return blobs, nil
+}
+
+// proposeTxListsInSharedBlobs packs the given encoded transactions lists into shared blobs, and proposes them.
+// For each shared blob, the first proposal carries the blob and asks the protocol to cache it, then all
+// later proposals reuse the cached blob at different offsets, without carrying the blob again.
+func (p *Proposer) proposeTxListsInSharedBlobs(
+	ctx context.Context,
+	txLists []*encodedTxList,
+) error {
+	blobs, err := packTxListsIntoBlobs(txLists)
+	if err != nil {
+		return err
+	}
+
+	for _, blob := range blobs {
+		first, err := newBlobTxList(blob.data, len(blob.txLists) > 1 || p.cfg.CacheBlobForReuse)
+		if err != nil {
+			return err
+		}
+		first.size = blob.sizes[0]
+
+		log.Info(
+			"Proposing transactions lists in a shared blob",
+			"blobHash", first.blobHash,
+			"txLists", len(blob.txLists),
+			"size", len(blob.data),
+		)
+
+		// The cached blob can only be reused after the first proposal is confirmed.
+		txListNonce := p.nonceManager.Next()
+		if err := p.proposeTxList(
+			ctx,
+			blob.data[:blob.sizes[0]],
+			uint(blob.txLists[0].Len()),
+			&txListNonce,
+			first,
+		); err != nil {
+			return err
+		}
+
+		g := new(errgroup.Group)
+		for i := 1; i < len(blob.txLists); i++ {
+			var (
+				txListNonce = p.nonceManager.Next()
+				ref         = &blobTxList{blobHash: first.blobHash, offset: blob.offsets[i], size: blob.sizes[i]}
+				txListBytes = blob.data[blob.offsets[i] : blob.offsets[i]+blob.sizes[i]]
+				txNum       = uint(blob.txLists[i].Len())
+			)
+			g.Go(func() error {
+				if err := p.proposeTxList(ctx, txListBytes, txNum, &txListNonce, ref); err != nil {
+					return fmt.Errorf("failed to propose transactions: %w", err)
+				}
+				return nil
+			})
+		}
+
+		if err := g.Wait(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
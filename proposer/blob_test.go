@@ -0,0 +1,66 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+func newTestTxList(n int, dataSize int) types.Transactions {
+	txs := make(types.Transactions, n)
+	for i := 0; i < n; i++ {
+		txs[i] = types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(i),
+			GasPrice: big.NewInt(1),
+			Gas:      21000,
+			Value:    common.Big0,
+			Data:     make([]byte, dataSize),
+		})
+	}
+	return txs
+}
+
+func newTestEncodedTxLists(t *testing.T, txLists ...types.Transactions) []*encodedTxList {
+	var encoded []*encodedTxList
+	for _, txs := range txLists {
+		txListBytes, err := rlp.EncodeToBytes(txs)
+		require.Nil(t, err)
+		encoded = append(encoded, &encodedTxList{txs: txs, bytes: txListBytes})
+	}
+	return encoded
+}
+
+func TestPackTxListsIntoBlobs(t *testing.T) {
+	txLists := newTestEncodedTxLists(
+		t,
+		newTestTxList(2, 1024),
+		newTestTxList(3, 2048),
+		newTestTxList(1, rpc.BlobDataSize/2),
+		newTestTxList(1, rpc.BlobDataSize/2),
+	)
+
+	blobs, err := packTxListsIntoBlobs(txLists)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(blobs))
+	require.Equal(t, 3, len(blobs[0].txLists))
+	require.Equal(t, 1, len(blobs[1].txLists))
+
+	for _, blob := range blobs {
+		require.LessOrEqual(t, len(blob.data), rpc.BlobDataSize)
+		require.Equal(t, uint64(0), blob.offsets[0])
+		for i, txs := range blob.txLists {
+			var decoded types.Transactions
+			require.Nil(t, rlp.DecodeBytes(blob.data[blob.offsets[i]:blob.offsets[i]+blob.sizes[i]], &decoded))
+			require.Equal(t, txs.Len(), decoded.Len())
+			require.Equal(t, txs[0].Hash(), decoded[0].Hash())
+		}
+	}
+
+	_, err = packTxListsIntoBlobs(newTestEncodedTxLists(t, newTestTxList(1, rpc.BlobDataSize)))
+	require.ErrorIs(t, err, rpc.ErrBlobDataTooLarge)
+}
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/taikoxyz/taiko-client/cmd/flags"
+	pricefeed "github.com/taikoxyz/taiko-client/pkg/price_feed"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+	policy "github.com/taikoxyz/taiko-client/proposer/tx_list_policy"
 	"github.com/urfave/cli/v2"
 )
 
@@ -23,10 +27,18 @@ type Config struct {
 	TaikoTokenAddress                   common.Address
 	AssignmentHookAddress               common.Address
 	L1ProposerPrivKey                   *ecdsa.PrivateKey
+	L1ProposerSigner                    signer.Signer
 	ExtraData                           string
 	ProposeInterval                     *time.Duration
 	LocalAddresses                      []common.Address
 	LocalAddressesOnly                  bool
+	AllowedSenders                      []common.Address
+	DeniedSenders                       []common.Address
+	DeniedContracts                     []common.Address
+	MaxTxsPerSender                     uint64
+	MinTxTip                            *big.Int
+	FeePriorityOrdering                 bool
+	TxListPolicies                      []policy.TxListPolicy
 	ProposeEmptyBlocksInterval          *time.Duration
 	MaxProposedTxListsPerEpoch          uint64
 	ProposeBlockTxGasLimit              *uint64
@@ -35,24 +47,83 @@ type Config struct {
 	RPCTimeout                          *time.Duration
 	WaitReceiptTimeout                  time.Duration
 	ProposeBlockTxGasTipCap             *big.Int
+	StuckTxTimeout                      time.Duration
 	ProverEndpoints                     []*url.URL
+	FeeToken                            common.Address
+	FeeTokenPriceFeed                   pricefeed.PriceFeed
+	FeeTokenAllowance                   *big.Int
+	ProverAuction                       bool
+	ProverAuctionDeadline               time.Duration
+	DryRun                              bool
+	DryRunFile                          string
+	ProverRegistryURL                   string
+	ProverRegistryFile                  string
+	ProverRegistryInterval              time.Duration
+	ForcedInclusionURL                  string
+	ForcedInclusionFile                 string
+	ForcedInclusionResubmitTimeout      time.Duration
+	ForcedInclusionMaxResubmissions     uint64
+	ForcedInclusionRetention            time.Duration
+	LeaderElectionFile                  string
+	LeaderElectionID                    string
+	LeaderLeaseTTL                      time.Duration
 	OptimisticTierFee                   *big.Int
 	SgxTierFee                          *big.Int
 	PseZkevmTierFee                     *big.Int
 	SgxAndPseZkevmTierFee               *big.Int
 	TierFeePriceBump                    *big.Int
 	MaxTierFeePriceBumps                uint64
+	ProverReputationFile                string
+	ProverCooldownThreshold             uint64
+	ProverCooldown                      time.Duration
+	ProverBlacklistScore                float64
+	ProverBlacklistDuration             time.Duration
 	IncludeParentMetaHash               bool
+	BlobAllowed                         bool
+	CacheBlobForReuse                   bool
+	PackTxListsIntoBlob                 bool
+	TxListCodec                         string
+	HookCallTemplates                   []*HookCallTemplate
+	FixedTip                            *big.Int
+	TipPriorityFeePercentage            uint64
+	ProfitabilityCheck                  bool
+	L2BaseFeeSharePercentage            uint64
+	ProposeBlockGasEstimate             uint64
+	AdaptiveProposeInterval             bool
+	MinProposeInterval                  time.Duration
+	MaxProposeInterval                  time.Duration
+	L1BaseFeeSpikePercentage            uint64
 }
 
 // NewConfigFromCliContext initializes a Config instance from
 // command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
-	l1ProposerPrivKey, err := crypto.ToECDSA(
-		common.Hex2Bytes(c.String(flags.L1ProposerPrivKey.Name)),
+	var (
+		l1ProposerPrivKey *ecdsa.PrivateKey
+		err               error
 	)
+	if c.IsSet(flags.L1ProposerPrivKey.Name) ||
+		(!c.IsSet(flags.SignerKeystore.Name) && !c.IsSet(flags.SignerRemoteURL.Name)) {
+		if l1ProposerPrivKey, err = crypto.ToECDSA(
+			common.Hex2Bytes(c.String(flags.L1ProposerPrivKey.Name)),
+		); err != nil {
+			return nil, fmt.Errorf("invalid L1 proposer private key: %w", err)
+		}
+	}
+
+	remoteSignerAddress := c.String(flags.SignerRemoteAddress.Name)
+	if c.IsSet(flags.SignerRemoteAddress.Name) && !common.IsHexAddress(remoteSignerAddress) {
+		return nil, fmt.Errorf("invalid --%s value: %s", flags.SignerRemoteAddress.Name, remoteSignerAddress)
+	}
+	l1ProposerSigner, err := signer.New(&signer.Config{
+		PrivateKey:    l1ProposerPrivKey,
+		KeystorePath:  c.String(flags.SignerKeystore.Name),
+		PasswordPath:  c.String(flags.SignerKeystorePassword.Name),
+		RemoteURL:     c.String(flags.SignerRemoteURL.Name),
+		RemoteAddress: common.HexToAddress(remoteSignerAddress),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("invalid L1 proposer private key: %w", err)
+		return nil, fmt.Errorf("invalid L1 proposer signer: %w", err)
 	}
 
 	// Proposing configuration
@@ -78,6 +149,19 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		}
 	}
 
+	allowedSenders, err := parseAddresses(c, flags.TxPoolAllowedSenders)
+	if err != nil {
+		return nil, err
+	}
+	deniedSenders, err := parseAddresses(c, flags.TxPoolDeniedSenders)
+	if err != nil {
+		return nil, err
+	}
+	deniedContracts, err := parseAddresses(c, flags.TxPoolDeniedContracts)
+	if err != nil {
+		return nil, err
+	}
+
 	var proposeBlockTxGasLimit *uint64
 	if c.IsSet(flags.ProposeBlockTxGasLimit.Name) {
 		gasLimit := c.Uint64(flags.ProposeBlockTxGasLimit.Name)
@@ -103,13 +187,165 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		proposeBlockTxGasTipCap = new(big.Int).SetUint64(c.Uint64(flags.ProposeBlockTxGasTipCap.Name))
 	}
 
-	var proverEndpoints []*url.URL
-	for _, e := range strings.Split(c.String(flags.ProverEndpoints.Name), ",") {
-		endpoint, err := url.Parse(e)
+	if c.Bool(flags.CacheBlobForReuse.Name) && !c.Bool(flags.BlobAllowed.Name) {
+		return nil, fmt.Errorf("--%s requires --%s", flags.CacheBlobForReuse.Name, flags.BlobAllowed.Name)
+	}
+	if c.Bool(flags.PackTxListsIntoBlob.Name) && !c.Bool(flags.BlobAllowed.Name) {
+		return nil, fmt.Errorf("--%s requires --%s", flags.PackTxListsIntoBlob.Name, flags.BlobAllowed.Name)
+	}
+	if c.Bool(flags.ProfitabilityCheck.Name) && c.Bool(flags.PackTxListsIntoBlob.Name) {
+		return nil, fmt.Errorf(
+			"--%s can not be used with --%s",
+			flags.ProfitabilityCheck.Name,
+			flags.PackTxListsIntoBlob.Name,
+		)
+	}
+	if c.Bool(flags.AdaptiveProposeInterval.Name) &&
+		(c.Duration(flags.MinProposeInterval.Name) <= 0 ||
+			c.Duration(flags.MinProposeInterval.Name) > c.Duration(flags.MaxProposeInterval.Name)) {
+		return nil, fmt.Errorf(
+			"invalid adaptive proposing interval range: [%s, %s]",
+			c.Duration(flags.MinProposeInterval.Name),
+			c.Duration(flags.MaxProposeInterval.Name),
+		)
+	}
+
+	var hookCallTemplates []*HookCallTemplate
+	if c.IsSet(flags.HookCalls.Name) {
+		for _, s := range strings.Split(c.String(flags.HookCalls.Name), ",") {
+			hookCall, err := ParseHookCallTemplate(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --%s value: %w", flags.HookCalls.Name, err)
+			}
+			hookCallTemplates = append(hookCallTemplates, hookCall)
+		}
+	}
+	if c.Uint64(flags.TipPriorityFeePercentage.Name) > 100 {
+		return nil, fmt.Errorf(
+			"invalid --%s value: %d",
+			flags.TipPriorityFeePercentage.Name,
+			c.Uint64(flags.TipPriorityFeePercentage.Name),
+		)
+	}
+
+	if c.Uint64(flags.L2BaseFeeSharePercentage.Name) > 100 {
+		return nil, fmt.Errorf(
+			"invalid --%s value: %d",
+			flags.L2BaseFeeSharePercentage.Name,
+			c.Uint64(flags.L2BaseFeeSharePercentage.Name),
+		)
+	}
+
+	if c.Float64(flags.ProverBlacklistScore.Name) >= 0 {
+		return nil, fmt.Errorf(
+			"invalid --%s value: %v",
+			flags.ProverBlacklistScore.Name,
+			c.Float64(flags.ProverBlacklistScore.Name),
+		)
+	}
+
+	var (
+		feeToken          common.Address
+		feeTokenPriceFeed pricefeed.PriceFeed
+		feeTokenAllowance *big.Int
+	)
+	if c.IsSet(flags.FeeToken.Name) {
+		if !common.IsHexAddress(c.String(flags.FeeToken.Name)) {
+			return nil, fmt.Errorf("invalid --%s value: %s", flags.FeeToken.Name, c.String(flags.FeeToken.Name))
+		}
+		feeToken = common.HexToAddress(c.String(flags.FeeToken.Name))
+
+		if !c.IsSet(flags.FeeTokenPrices.Name) {
+			return nil, fmt.Errorf("--%s requires --%s", flags.FeeToken.Name, flags.FeeTokenPrices.Name)
+		}
+		prices, err := pricefeed.ParsePrices(c.String(flags.FeeTokenPrices.Name))
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("invalid --%s value: %w", flags.FeeTokenPrices.Name, err)
+		}
+		if _, ok := prices[feeToken]; !ok {
+			return nil, fmt.Errorf("no price for fee token %s in --%s", feeToken.Hex(), flags.FeeTokenPrices.Name)
+		}
+		feeTokenPriceFeed = pricefeed.NewStaticPriceFeed(prices)
+	}
+	if c.IsSet(flags.FeeTokenAllowance.Name) {
+		amount, ok := new(big.Int).SetString(c.String(flags.FeeTokenAllowance.Name), 10)
+		if !ok {
+			return nil, fmt.Errorf(
+				"invalid --%s value: %s",
+				flags.FeeTokenAllowance.Name,
+				c.String(flags.FeeTokenAllowance.Name),
+			)
+		}
+		feeTokenAllowance = amount
+	}
+
+	if c.Bool(flags.ProverAuction.Name) && c.Duration(flags.ProverAuctionDeadline.Name) == 0 {
+		return nil, fmt.Errorf("invalid --%s value: 0", flags.ProverAuctionDeadline.Name)
+	}
+
+	if c.IsSet(flags.DryRunFile.Name) && !c.Bool(flags.DryRun.Name) {
+		return nil, fmt.Errorf("--%s requires --%s", flags.DryRunFile.Name, flags.DryRun.Name)
+	}
+
+	// In dry-run mode, the first proposal of a shared blob is never sent, so the blob can't be reused
+	// by the following proposals.
+	if c.Bool(flags.DryRun.Name) && c.Bool(flags.PackTxListsIntoBlob.Name) {
+		return nil, fmt.Errorf(
+			"--%s and --%s cannot be set at the same time",
+			flags.DryRun.Name,
+			flags.PackTxListsIntoBlob.Name,
+		)
+	}
+
+	leaderElectionID := c.String(flags.LeaderElectionID.Name)
+	if c.IsSet(flags.LeaderElectionFile.Name) {
+		if c.Duration(flags.LeaderLeaseTTL.Name) == 0 {
+			return nil, fmt.Errorf("invalid --%s value: 0", flags.LeaderLeaseTTL.Name)
+		}
+		if leaderElectionID == "" {
+			hostname, err := os.Hostname()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get hostname for leader election ID: %w", err)
+			}
+			leaderElectionID = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+		}
+	}
+
+	if c.IsSet(flags.ForcedInclusionURL.Name) && c.IsSet(flags.ForcedInclusionFile.Name) {
+		return nil, fmt.Errorf(
+			"--%s and --%s cannot be set at the same time",
+			flags.ForcedInclusionURL.Name,
+			flags.ForcedInclusionFile.Name,
+		)
+	}
+
+	if c.IsSet(flags.ProverRegistryURL.Name) && c.IsSet(flags.ProverRegistryFile.Name) {
+		return nil, fmt.Errorf(
+			"--%s and --%s cannot be set at the same time",
+			flags.ProverRegistryURL.Name,
+			flags.ProverRegistryFile.Name,
+		)
+	}
+	if !c.IsSet(flags.ProverEndpoints.Name) &&
+		!c.IsSet(flags.ProverRegistryURL.Name) &&
+		!c.IsSet(flags.ProverRegistryFile.Name) {
+		return nil, fmt.Errorf(
+			"one of --%s, --%s and --%s is required",
+			flags.ProverEndpoints.Name,
+			flags.ProverRegistryURL.Name,
+			flags.ProverRegistryFile.Name,
+		)
+	}
+
+	var proverEndpoints []*url.URL
+	if c.IsSet(flags.ProverEndpoints.Name) {
+		for _, e := range strings.Split(c.String(flags.ProverEndpoints.Name), ",") {
+			endpoint, err := url.Parse(e)
+			if err != nil {
+				return nil, err
+			}
+			proverEndpoints = append(proverEndpoints, endpoint)
 		}
-		proverEndpoints = append(proverEndpoints, endpoint)
 	}
 
 	return &Config{
@@ -120,10 +356,17 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		TaikoTokenAddress:                   common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
 		AssignmentHookAddress:               common.HexToAddress(c.String(flags.ProposerAssignmentHookAddress.Name)),
 		L1ProposerPrivKey:                   l1ProposerPrivKey,
+		L1ProposerSigner:                    l1ProposerSigner,
 		ExtraData:                           c.String(flags.ExtraData.Name),
 		ProposeInterval:                     proposingInterval,
 		LocalAddresses:                      localAddresses,
 		LocalAddressesOnly:                  c.Bool(flags.TxPoolLocalsOnly.Name),
+		AllowedSenders:                      allowedSenders,
+		DeniedSenders:                       deniedSenders,
+		DeniedContracts:                     deniedContracts,
+		MaxTxsPerSender:                     c.Uint64(flags.TxPoolMaxTxsPerSender.Name),
+		MinTxTip:                            new(big.Int).SetUint64(c.Uint64(flags.TxPoolMinTip.Name)),
+		FeePriorityOrdering:                 c.Bool(flags.TxPoolFeePriority.Name),
 		ProposeEmptyBlocksInterval:          proposeEmptyBlocksInterval,
 		MaxProposedTxListsPerEpoch:          c.Uint64(flags.MaxProposedTxListsPerEpoch.Name),
 		ProposeBlockTxGasLimit:              proposeBlockTxGasLimit,
@@ -132,13 +375,69 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		RPCTimeout:                          timeout,
 		WaitReceiptTimeout:                  c.Duration(flags.WaitReceiptTimeout.Name),
 		ProposeBlockTxGasTipCap:             proposeBlockTxGasTipCap,
+		StuckTxTimeout:                      c.Duration(flags.StuckTxTimeout.Name),
 		ProverEndpoints:                     proverEndpoints,
+		FeeToken:                            feeToken,
+		FeeTokenPriceFeed:                   feeTokenPriceFeed,
+		FeeTokenAllowance:                   feeTokenAllowance,
+		ProverAuction:                       c.Bool(flags.ProverAuction.Name),
+		ProverAuctionDeadline:               c.Duration(flags.ProverAuctionDeadline.Name),
+		DryRun:                              c.Bool(flags.DryRun.Name),
+		DryRunFile:                          c.String(flags.DryRunFile.Name),
+		ProverRegistryURL:                   c.String(flags.ProverRegistryURL.Name),
+		ProverRegistryFile:                  c.String(flags.ProverRegistryFile.Name),
+		ProverRegistryInterval:              c.Duration(flags.ProverRegistryInterval.Name),
+		ForcedInclusionURL:                  c.String(flags.ForcedInclusionURL.Name),
+		ForcedInclusionFile:                 c.String(flags.ForcedInclusionFile.Name),
+		ForcedInclusionResubmitTimeout:      c.Duration(flags.ForcedInclusionResubmitTimeout.Name),
+		ForcedInclusionMaxResubmissions:     c.Uint64(flags.ForcedInclusionMaxResubmissions.Name),
+		ForcedInclusionRetention:            c.Duration(flags.ForcedInclusionRetention.Name),
+		LeaderElectionFile:                  c.String(flags.LeaderElectionFile.Name),
+		LeaderElectionID:                    leaderElectionID,
+		LeaderLeaseTTL:                      c.Duration(flags.LeaderLeaseTTL.Name),
 		OptimisticTierFee:                   new(big.Int).SetUint64(c.Uint64(flags.OptimisticTierFee.Name)),
 		SgxTierFee:                          new(big.Int).SetUint64(c.Uint64(flags.SgxTierFee.Name)),
 		PseZkevmTierFee:                     new(big.Int).SetUint64(c.Uint64(flags.PseZkevmTierFee.Name)),
 		SgxAndPseZkevmTierFee:               new(big.Int).SetUint64(c.Uint64(flags.SgxAndPseZkevmTierFee.Name)),
 		TierFeePriceBump:                    new(big.Int).SetUint64(c.Uint64(flags.TierFeePriceBump.Name)),
 		MaxTierFeePriceBumps:                c.Uint64(flags.MaxTierFeePriceBumps.Name),
+		ProverReputationFile:                c.String(flags.ProverReputationFile.Name),
+		ProverCooldownThreshold:             c.Uint64(flags.ProverCooldownThreshold.Name),
+		ProverCooldown:                      c.Duration(flags.ProverCooldown.Name),
+		ProverBlacklistScore:                c.Float64(flags.ProverBlacklistScore.Name),
+		ProverBlacklistDuration:             c.Duration(flags.ProverBlacklistDuration.Name),
 		IncludeParentMetaHash:               c.Bool(flags.ProposeBlockIncludeParentMetaHash.Name),
+		BlobAllowed:                         c.Bool(flags.BlobAllowed.Name),
+		CacheBlobForReuse:                   c.Bool(flags.CacheBlobForReuse.Name),
+		PackTxListsIntoBlob:                 c.Bool(flags.PackTxListsIntoBlob.Name),
+		TxListCodec:                         c.String(flags.TxListCodec.Name),
+		HookCallTemplates:                   hookCallTemplates,
+		FixedTip:                            new(big.Int).SetUint64(c.Uint64(flags.FixedTip.Name)),
+		TipPriorityFeePercentage:            c.Uint64(flags.TipPriorityFeePercentage.Name),
+		ProfitabilityCheck:                  c.Bool(flags.ProfitabilityCheck.Name),
+		L2BaseFeeSharePercentage:            c.Uint64(flags.L2BaseFeeSharePercentage.Name),
+		ProposeBlockGasEstimate:             c.Uint64(flags.ProposeBlockGasEstimate.Name),
+		AdaptiveProposeInterval:             c.Bool(flags.AdaptiveProposeInterval.Name),
+		MinProposeInterval:                  c.Duration(flags.MinProposeInterval.Name),
+		MaxProposeInterval:                  c.Duration(flags.MaxProposeInterval.Name),
+		L1BaseFeeSpikePercentage:            c.Uint64(flags.L1BaseFeeSpikePercentage.Name),
 	}, nil
 }
+
+// parseAddresses parses the comma separated accounts of the given flag.
+func parseAddresses(c *cli.Context, flag *cli.StringFlag) ([]common.Address, error) {
+	var addresses []common.Address
+	if !c.IsSet(flag.Name) {
+		return addresses, nil
+	}
+
+	for _, account := range strings.Split(c.String(flag.Name), ",") {
+		trimmed := strings.TrimSpace(account)
+		if !common.IsHexAddress(trimmed) {
+			return nil, fmt.Errorf("invalid account in --%s: %s", flag.Name, trimmed)
+		}
+		addresses = append(addresses, common.HexToAddress(trimmed))
+	}
+
+	return addresses, nil
+}
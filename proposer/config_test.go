@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/taikoxyz/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-client/pkg/txlistcodec"
 	"github.com/urfave/cli/v2"
 )
 
@@ -46,9 +47,14 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		s.Equal(float64(10), c.ProposeInterval.Seconds())
 		s.Equal(1, len(c.LocalAddresses))
 		s.Equal(goldenTouchAddress, c.LocalAddresses[0])
+		s.Equal([]common.Address{goldenTouchAddress}, c.DeniedSenders)
+		s.Equal(uint64(8), c.MaxTxsPerSender)
+		s.Equal(uint64(1000), c.MinTxTip.Uint64())
+		s.True(c.FeePriorityOrdering)
 		s.Equal(uint64(5), c.ProposeBlockTxReplacementMultiplier)
 		s.Equal(5*time.Second, *c.RPCTimeout)
 		s.Equal(10*time.Second, c.WaitReceiptTimeout)
+		s.Equal(5*time.Minute, c.StuckTxTimeout)
 		s.Equal(uint64(tierFee), c.OptimisticTierFee.Uint64())
 		s.Equal(uint64(tierFee), c.SgxTierFee.Uint64())
 		s.Equal(uint64(tierFee), c.PseZkevmTierFee.Uint64())
@@ -56,6 +62,17 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		s.Equal(uint64(15), c.TierFeePriceBump.Uint64())
 		s.Equal(uint64(5), c.MaxTierFeePriceBumps)
 		s.Equal(true, c.IncludeParentMetaHash)
+		s.Equal(txlistcodec.Brotli, c.TxListCodec)
+		s.True(c.ProfitabilityCheck)
+		s.Equal(uint64(25), c.L2BaseFeeSharePercentage)
+		s.Len(c.HookCallTemplates, 1)
+		s.Equal(uint64(100), c.FixedTip.Uint64())
+		s.Equal(uint64(10), c.TipPriorityFeePercentage)
+		s.Equal(uint64(300_000), c.ProposeBlockGasEstimate)
+		s.True(c.AdaptiveProposeInterval)
+		s.Equal(6*time.Second, c.MinProposeInterval)
+		s.Equal(60*time.Second, c.MaxProposeInterval)
+		s.Equal(uint64(200), c.L1BaseFeeSpikePercentage)
 
 		for i, e := range strings.Split(proverEndpoints, ",") {
 			s.Equal(c.ProverEndpoints[i].String(), e)
@@ -75,10 +92,15 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
 		"--" + flags.ProposeInterval.Name, proposeInterval,
 		"--" + flags.TxPoolLocals.Name, goldenTouchAddress.Hex(),
+		"--" + flags.TxPoolDeniedSenders.Name, goldenTouchAddress.Hex(),
+		"--" + flags.TxPoolMaxTxsPerSender.Name, "8",
+		"--" + flags.TxPoolMinTip.Name, "1000",
+		"--" + flags.TxPoolFeePriority.Name,
 		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
 		"--" + flags.RPCTimeout.Name, rpcTimeout,
 		"--" + flags.WaitReceiptTimeout.Name, "10s",
 		"--" + flags.ProposeBlockTxGasTipCap.Name, "100000",
+		"--" + flags.StuckTxTimeout.Name, "5m",
 		"--" + flags.ProposeBlockTxGasLimit.Name, "100000",
 		"--" + flags.ProverEndpoints.Name, proverEndpoints,
 		"--" + flags.OptimisticTierFee.Name, fmt.Sprint(tierFee),
@@ -88,6 +110,17 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		"--" + flags.TierFeePriceBump.Name, "15",
 		"--" + flags.MaxTierFeePriceBumps.Name, "5",
 		"--" + flags.ProposeBlockIncludeParentMetaHash.Name, "true",
+		"--" + flags.TxListCodec.Name, txlistcodec.Brotli,
+		"--" + flags.ProfitabilityCheck.Name,
+		"--" + flags.L2BaseFeeSharePercentage.Name, "25",
+		"--" + flags.HookCalls.Name, common.BigToAddress(common.Big1).Hex() + ":0x{{.Proposer}}",
+		"--" + flags.FixedTip.Name, "100",
+		"--" + flags.TipPriorityFeePercentage.Name, "10",
+		"--" + flags.ProposeBlockGasEstimate.Name, "300000",
+		"--" + flags.AdaptiveProposeInterval.Name,
+		"--" + flags.MinProposeInterval.Name, "6s",
+		"--" + flags.MaxProposeInterval.Name, "60s",
+		"--" + flags.L1BaseFeeSpikePercentage.Name, "200",
 	}))
 }
 
@@ -115,6 +148,19 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContextTxPoolLocalsErr() {
 	}), "invalid account in --txpool.locals")
 }
 
+func (s *ProposerTestSuite) TestNewConfigFromCliContextTxPoolDeniedContractsErr() {
+	goldenTouchPrivKey, err := s.RPCClient.TaikoL2.GOLDENTOUCHPRIVATEKEY(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextTxPoolDeniedContractsErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.TxPoolDeniedContracts.Name, "notAnAddress",
+	}), "invalid account in --txpool.deniedContracts")
+}
+
 func (s *ProposerTestSuite) TestNewConfigFromCliContextReplMultErr() {
 	goldenTouchAddress, err := s.RPCClient.TaikoL2.GOLDENTOUCHADDRESS(nil)
 	s.Nil(err)
@@ -134,6 +180,170 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContextReplMultErr() {
 	}), "invalid --proposeBlockTxReplacementMultiplier value")
 }
 
+func (s *ProposerTestSuite) TestNewConfigFromCliContextCacheBlobErr() {
+	goldenTouchPrivKey, err := s.RPCClient.TaikoL2.GOLDENTOUCHPRIVATEKEY(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextCacheBlobErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.CacheBlobForReuse.Name,
+	}), "--l1.cacheBlobForReuse requires --l1.blobAllowed")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextHookCallsErr() {
+	goldenTouchPrivKey, err := s.RPCClient.TaikoL2.GOLDENTOUCHPRIVATEKEY(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextHookCallsErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.HookCalls.Name, "0x123:0x00",
+	}), "invalid --hooks.calls value")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextBaseFeeShareErr() {
+	goldenTouchPrivKey, err := s.RPCClient.TaikoL2.GOLDENTOUCHPRIVATEKEY(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextBaseFeeShareErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.L2BaseFeeSharePercentage.Name, "101",
+	}), "invalid --profitability.baseFeeSharePercentage value")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextBlacklistScoreErr() {
+	goldenTouchPrivKey, err := s.RPCClient.TaikoL2.GOLDENTOUCHPRIVATEKEY(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextBlacklistScoreErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.ProverBlacklistScore.Name, "1",
+	}), "invalid --proverReputation.blacklistScore value")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextProverRegistryErr() {
+	goldenTouchPrivKey, err := s.RPCClient.TaikoL2.GOLDENTOUCHPRIVATEKEY(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextProverRegistryErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+	}), "one of --proverEndpoints, --proverRegistry.url and --proverRegistry.file is required")
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextProverRegistryErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.ProverRegistryURL.Name, "http://localhost:9000",
+		"--" + flags.ProverRegistryFile.Name, "provers.json",
+	}), "cannot be set at the same time")
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextProverRegistryErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.ForcedInclusionURL.Name, "http://localhost:9000",
+		"--" + flags.ForcedInclusionFile.Name, "forced_txs.json",
+	}), "--forcedInclusion.url and --forcedInclusion.file cannot be set at the same time")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextFeeTokenErr() {
+	goldenTouchPrivKey, err := s.RPCClient.TaikoL2.GOLDENTOUCHPRIVATEKEY(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextFeeTokenErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.FeeToken.Name, common.BigToAddress(common.Big1).Hex(),
+	}), "--feeToken.address requires --feeToken.prices")
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextFeeTokenErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.FeeToken.Name, common.BigToAddress(common.Big1).Hex(),
+		"--" + flags.FeeTokenPrices.Name, common.BigToAddress(common.Big2).Hex() + ":1",
+	}), "no price for fee token")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextAdaptiveIntervalErr() {
+	goldenTouchPrivKey, err := s.RPCClient.TaikoL2.GOLDENTOUCHPRIVATEKEY(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextAdaptiveIntervalErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.AdaptiveProposeInterval.Name,
+		"--" + flags.MinProposeInterval.Name, "60s",
+		"--" + flags.MaxProposeInterval.Name, "6s",
+	}), "invalid adaptive proposing interval range")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextDryRunErr() {
+	goldenTouchPrivKey, err := s.RPCClient.TaikoL2.GOLDENTOUCHPRIVATEKEY(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextDryRunErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.ProverEndpoints.Name, "http://localhost:9876",
+		"--" + flags.DryRunFile.Name, "proposals.jsonl",
+	}), "--dryRun.file requires --dryRun.enabled")
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextDryRunErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.ProverEndpoints.Name, "http://localhost:9876",
+		"--" + flags.DryRun.Name,
+		"--" + flags.BlobAllowed.Name,
+		"--" + flags.PackTxListsIntoBlob.Name,
+	}), "--dryRun.enabled and --l1.blobPackTxLists cannot be set at the same time")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextLeaderElectionErr() {
+	goldenTouchPrivKey, err := s.RPCClient.TaikoL2.GOLDENTOUCHPRIVATEKEY(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextLeaderElectionErr",
+		"--" + flags.L1ProposerPrivKey.Name, common.Bytes2Hex(goldenTouchPrivKey.Bytes()),
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
+		"--" + flags.ProverEndpoints.Name, "http://localhost:9876",
+		"--" + flags.LeaderElectionFile.Name, "lease.json",
+		"--" + flags.LeaderLeaseTTL.Name, "0s",
+	}), "invalid --leaderElection.leaseTTL value: 0")
+}
+
 func (s *ProposerTestSuite) SetupApp() *cli.App {
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
@@ -143,10 +353,38 @@ func (s *ProposerTestSuite) SetupApp() *cli.App {
 		&cli.StringFlag{Name: flags.TaikoL2Address.Name},
 		&cli.StringFlag{Name: flags.TaikoTokenAddress.Name},
 		&cli.StringFlag{Name: flags.L1ProposerPrivKey.Name},
+		&cli.StringFlag{Name: flags.SignerKeystore.Name},
+		&cli.StringFlag{Name: flags.SignerKeystorePassword.Name},
+		&cli.StringFlag{Name: flags.SignerRemoteURL.Name},
+		&cli.StringFlag{Name: flags.SignerRemoteAddress.Name},
 		&cli.DurationFlag{Name: flags.ProposeEmptyBlocksInterval.Name},
 		&cli.DurationFlag{Name: flags.ProposeInterval.Name},
 		&cli.StringFlag{Name: flags.TxPoolLocals.Name},
+		&cli.StringFlag{Name: flags.TxPoolAllowedSenders.Name},
+		&cli.StringFlag{Name: flags.TxPoolDeniedSenders.Name},
+		&cli.StringFlag{Name: flags.TxPoolDeniedContracts.Name},
+		&cli.Uint64Flag{Name: flags.TxPoolMaxTxsPerSender.Name},
+		&cli.Uint64Flag{Name: flags.TxPoolMinTip.Name},
+		&cli.BoolFlag{Name: flags.TxPoolFeePriority.Name},
 		&cli.StringFlag{Name: flags.ProverEndpoints.Name},
+		&cli.StringFlag{Name: flags.FeeToken.Name},
+		&cli.StringFlag{Name: flags.FeeTokenPrices.Name},
+		&cli.StringFlag{Name: flags.FeeTokenAllowance.Name},
+		&cli.BoolFlag{Name: flags.ProverAuction.Name},
+		&cli.DurationFlag{Name: flags.ProverAuctionDeadline.Name},
+		&cli.BoolFlag{Name: flags.DryRun.Name},
+		&cli.StringFlag{Name: flags.DryRunFile.Name},
+		&cli.StringFlag{Name: flags.ProverRegistryURL.Name},
+		&cli.StringFlag{Name: flags.ProverRegistryFile.Name},
+		&cli.DurationFlag{Name: flags.ProverRegistryInterval.Name},
+		&cli.StringFlag{Name: flags.ForcedInclusionURL.Name},
+		&cli.StringFlag{Name: flags.ForcedInclusionFile.Name},
+		&cli.DurationFlag{Name: flags.ForcedInclusionResubmitTimeout.Name},
+		&cli.Uint64Flag{Name: flags.ForcedInclusionMaxResubmissions.Name},
+		&cli.DurationFlag{Name: flags.ForcedInclusionRetention.Name},
+		&cli.StringFlag{Name: flags.LeaderElectionFile.Name},
+		&cli.StringFlag{Name: flags.LeaderElectionID.Name},
+		&cli.DurationFlag{Name: flags.LeaderLeaseTTL.Name},
 		&cli.Uint64Flag{Name: flags.OptimisticTierFee.Name},
 		&cli.Uint64Flag{Name: flags.SgxTierFee.Name},
 		&cli.Uint64Flag{Name: flags.PseZkevmTierFee.Name},
@@ -155,11 +393,31 @@ func (s *ProposerTestSuite) SetupApp() *cli.App {
 		&cli.DurationFlag{Name: flags.RPCTimeout.Name},
 		&cli.DurationFlag{Name: flags.WaitReceiptTimeout.Name},
 		&cli.Uint64Flag{Name: flags.ProposeBlockTxGasTipCap.Name},
+		&cli.DurationFlag{Name: flags.StuckTxTimeout.Name},
 		&cli.Uint64Flag{Name: flags.ProposeBlockTxGasLimit.Name},
 		&cli.Uint64Flag{Name: flags.TierFeePriceBump.Name},
 		&cli.Uint64Flag{Name: flags.MaxTierFeePriceBumps.Name},
+		&cli.StringFlag{Name: flags.ProverReputationFile.Name},
+		&cli.Uint64Flag{Name: flags.ProverCooldownThreshold.Name},
+		&cli.DurationFlag{Name: flags.ProverCooldown.Name},
+		&cli.Float64Flag{Name: flags.ProverBlacklistScore.Name, Value: flags.ProverBlacklistScore.Value},
+		&cli.DurationFlag{Name: flags.ProverBlacklistDuration.Name},
 		&cli.BoolFlag{Name: flags.ProposeBlockIncludeParentMetaHash.Name},
 		&cli.StringFlag{Name: flags.ProposerAssignmentHookAddress.Name},
+		&cli.BoolFlag{Name: flags.BlobAllowed.Name},
+		&cli.BoolFlag{Name: flags.CacheBlobForReuse.Name},
+		&cli.BoolFlag{Name: flags.PackTxListsIntoBlob.Name},
+		&cli.StringFlag{Name: flags.TxListCodec.Name},
+		&cli.BoolFlag{Name: flags.ProfitabilityCheck.Name},
+		&cli.Uint64Flag{Name: flags.L2BaseFeeSharePercentage.Name},
+		&cli.StringFlag{Name: flags.HookCalls.Name},
+		&cli.Uint64Flag{Name: flags.FixedTip.Name},
+		&cli.Uint64Flag{Name: flags.TipPriorityFeePercentage.Name},
+		&cli.Uint64Flag{Name: flags.ProposeBlockGasEstimate.Name},
+		&cli.BoolFlag{Name: flags.AdaptiveProposeInterval.Name},
+		&cli.DurationFlag{Name: flags.MinProposeInterval.Name},
+		&cli.DurationFlag{Name: flags.MaxProposeInterval.Name},
+		&cli.Uint64Flag{Name: flags.L1BaseFeeSpikePercentage.Name},
 	}
 	app.Action = func(ctx *cli.Context) error {
 		_, err := NewConfigFromCliContext(ctx)
@@ -0,0 +1,130 @@
+package proposer
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/metrics"
+)
+
+// dryRunProposal is a would-be TaikoL1.proposeBlock transaction recorded in dry-run mode.
+type dryRunProposal struct {
+	Timestamp  uint64             `json:"timestamp"`
+	TxListHash common.Hash        `json:"txListHash"`
+	BlobHash   *common.Hash       `json:"blobHash,omitempty"`
+	Size       int                `json:"size"`
+	TxCount    uint               `json:"txCount"`
+	Prover     common.Address     `json:"prover"`
+	FeeToken   common.Address     `json:"feeToken"`
+	TierFees   []encoding.TierFee `json:"tierFees"`
+	ProverFee  *big.Int           `json:"proverFee"`
+	Tip        *big.Int           `json:"tip"`
+	Gas        uint64             `json:"gas"`
+	GasFeeCap  *big.Int           `json:"gasFeeCap"`
+	GasTipCap  *big.Int           `json:"gasTipCap"`
+}
+
+// newDryRunProposal creates a new dryRunProposal from the given signed but not sent transaction.
+func newDryRunProposal(
+	tx *types.Transaction,
+	txListHash common.Hash,
+	blob *blobTxList,
+	size int,
+	txNum uint,
+	assignment *encoding.ProverAssignment,
+	prover common.Address,
+	maxFee *big.Int,
+	tip *big.Int,
+) *dryRunProposal {
+	proposal := &dryRunProposal{
+		Timestamp:  uint64(time.Now().Unix()),
+		TxListHash: txListHash,
+		Size:       size,
+		TxCount:    txNum,
+		Prover:     prover,
+		FeeToken:   assignment.FeeToken,
+		TierFees:   assignment.TierFees,
+		ProverFee:  maxFee,
+		Tip:        tip,
+		Gas:        tx.Gas(),
+		GasFeeCap:  tx.GasFeeCap(),
+		GasTipCap:  tx.GasTipCap(),
+	}
+	if blob != nil {
+		proposal.BlobHash = &blob.blobHash
+	}
+
+	return proposal
+}
+
+// dryRunRecorder records the would-be proposals in dry-run mode to metrics, and to a JSONL file if
+// a file path is given.
+type dryRunRecorder struct {
+	file *os.File
+	mu   sync.Mutex
+}
+
+// newDryRunRecorder creates a new dryRunRecorder instance, the records are appended to the given file.
+func newDryRunRecorder(path string) (*dryRunRecorder, error) {
+	if path == "" {
+		return &dryRunRecorder{}, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dryRunRecorder{file: file}, nil
+}
+
+// record records the given would-be proposal.
+func (r *dryRunRecorder) record(proposal *dryRunProposal) error {
+	log.Info(
+		"Dry-run proposal recorded",
+		"txListHash", proposal.TxListHash,
+		"size", proposal.Size,
+		"txs", proposal.TxCount,
+		"prover", proposal.Prover,
+		"proverFee", proposal.ProverFee,
+		"tip", proposal.Tip,
+		"gas", proposal.Gas,
+	)
+
+	metrics.ProposerDryRunTxListsCounter.Inc(1)
+	metrics.ProposerDryRunTxsCounter.Inc(int64(proposal.TxCount))
+	metrics.ProposerDryRunGasGauge.Update(int64(proposal.Gas))
+	if proposal.ProverFee != nil && proposal.ProverFee.IsInt64() {
+		metrics.ProposerDryRunProverFeeGauge.Update(proposal.ProverFee.Int64())
+	}
+
+	if r.file == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(proposal)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, err = r.file.Write(append(b, '\n'))
+	return err
+}
+
+// Close closes the underlying file.
+func (r *dryRunRecorder) Close() error {
+	if r.file == nil {
+		return nil
+	}
+	return r.file.Close()
+}
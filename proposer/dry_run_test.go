@@ -0,0 +1,71 @@
+package proposer
+
+import (
+	"bufio"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func TestDryRunRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposals.jsonl")
+
+	r, err := newDryRunRecorder(path)
+	require.Nil(t, err)
+
+	var (
+		tx = types.NewTx(&types.DynamicFeeTx{
+			Gas:       210000,
+			GasFeeCap: big.NewInt(2),
+			GasTipCap: common.Big1,
+		})
+		assignment = &encoding.ProverAssignment{
+			TierFees: []encoding.TierFee{{Tier: encoding.TierOptimisticID, Fee: common.Big256}},
+		}
+		prover = common.BigToAddress(common.Big1)
+		blob   = &blobTxList{blobHash: common.BigToHash(common.Big2)}
+	)
+
+	require.Nil(t, r.record(newDryRunProposal(
+		tx, common.BigToHash(common.Big1), nil, 100, 3, assignment, prover, common.Big256, common.Big1,
+	)))
+	require.Nil(t, r.record(newDryRunProposal(
+		tx, common.BigToHash(common.Big1), blob, 200, 5, assignment, prover, common.Big256, common.Big0,
+	)))
+	require.Nil(t, r.Close())
+
+	f, err := os.Open(path)
+	require.Nil(t, err)
+	defer f.Close()
+
+	var proposals []*dryRunProposal
+	for scanner := bufio.NewScanner(f); scanner.Scan(); {
+		proposal := new(dryRunProposal)
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), proposal))
+		proposals = append(proposals, proposal)
+	}
+
+	require.Len(t, proposals, 2)
+	require.Nil(t, proposals[0].BlobHash)
+	require.Equal(t, uint(3), proposals[0].TxCount)
+	require.Equal(t, uint64(210000), proposals[0].Gas)
+	require.Equal(t, prover, proposals[0].Prover)
+	require.Equal(t, common.Big256, proposals[0].ProverFee)
+	require.Equal(t, blob.blobHash, *proposals[1].BlobHash)
+	require.Equal(t, 200, proposals[1].Size)
+}
+
+func TestDryRunRecorderWithoutFile(t *testing.T) {
+	r, err := newDryRunRecorder("")
+	require.Nil(t, err)
+
+	require.Nil(t, r.record(&dryRunProposal{TxCount: 1}))
+	require.Nil(t, r.Close())
+}
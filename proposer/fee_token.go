@@ -0,0 +1,138 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings"
+	pricefeed "github.com/taikoxyz/taiko-client/pkg/price_feed"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+	selector "github.com/taikoxyz/taiko-client/proposer/prover_selector"
+)
+
+var errInsufficientFeeTokenAllowance = errors.New("insufficient fee token allowance for the AssignmentHook contract")
+
+// setFeeTokenAllowance sets the proposer's allowance of the ERC-20 fee token for the AssignmentHook contract,
+// which transfers the prover fees from the proposer, if `--feeToken.allowance` flag is provided.
+func (p *Proposer) setFeeTokenAllowance(ctx context.Context) error {
+	if p.cfg.FeeToken == rpc.ZeroAddress || p.cfg.FeeTokenAllowance == nil || p.cfg.FeeTokenAllowance.Sign() <= 0 {
+		return nil
+	}
+
+	// Only the standard ERC-20 methods of the TaikoToken bindings are used here.
+	token, err := bindings.NewTaikoToken(p.cfg.FeeToken, p.rpc.L1)
+	if err != nil {
+		return err
+	}
+
+	allowance, err := token.Allowance(&bind.CallOpts{Context: ctx}, p.proposerAddress, p.cfg.AssignmentHookAddress)
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(p.cfg.FeeTokenAllowance) >= 0 {
+		log.Info(
+			"Skipping setting fee token allowance, allowance already greater or equal",
+			"allowance", allowance,
+			"approvalAmount", p.cfg.FeeTokenAllowance,
+			"feeToken", p.cfg.FeeToken,
+		)
+		return nil
+	}
+
+	return p.approveFeeToken(ctx, token, nil)
+}
+
+// ensureFeeTokenAllowance makes sure the proposer's allowance of the ERC-20 fee token for the AssignmentHook
+// contract covers the max prover fees of the given number of transactions lists, with all tier fee price
+// bumps of the prover selector applied, before proposing them.
+// The allowance is topped up to the `--feeToken.allowance` flag value if it is provided, otherwise an error
+// is returned, since all the TaikoL1.proposeBlock transactions would revert.
+func (p *Proposer) ensureFeeTokenAllowance(ctx context.Context, txListsNum int) error {
+	if p.cfg.FeeToken == rpc.ZeroAddress {
+		return nil
+	}
+
+	maxFee, err := pricefeed.WeiToToken(ctx, p.cfg.FeeTokenPriceFeed, p.cfg.FeeToken, maxTierFee(p.tierFees))
+	if err != nil {
+		return err
+	}
+	maxFee = selector.MaxBumpedTierFee(maxFee, p.cfg.TierFeePriceBump, p.cfg.MaxTierFeePriceBumps)
+	required := new(big.Int).Mul(maxFee, big.NewInt(int64(txListsNum)))
+
+	token, err := bindings.NewTaikoToken(p.cfg.FeeToken, p.rpc.L1)
+	if err != nil {
+		return err
+	}
+
+	allowance, err := token.Allowance(&bind.CallOpts{Context: ctx}, p.proposerAddress, p.cfg.AssignmentHookAddress)
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(required) >= 0 {
+		return nil
+	}
+
+	if p.cfg.FeeTokenAllowance == nil || p.cfg.FeeTokenAllowance.Cmp(required) < 0 {
+		return fmt.Errorf(
+			"%w: allowance %s, required %s, approvalAmount %v",
+			errInsufficientFeeTokenAllowance,
+			allowance,
+			required,
+			p.cfg.FeeTokenAllowance,
+		)
+	}
+
+	log.Info("Topping up fee token allowance", "allowance", allowance, "required", required)
+
+	nonce := p.nonceManager.Next()
+	return p.approveFeeToken(ctx, token, &nonce)
+}
+
+// proverFeeInWei converts the given prover fee returned by the prover selector, which is in the fee token's
+// units, to wei.
+func (p *Proposer) proverFeeInWei(ctx context.Context, fee *big.Int) (*big.Int, error) {
+	if p.cfg.FeeToken == rpc.ZeroAddress {
+		return fee, nil
+	}
+
+	return pricefeed.TokenToWei(ctx, p.cfg.FeeTokenPriceFeed, p.cfg.FeeToken, fee)
+}
+
+// approveFeeToken approves the AssignmentHook contract for the `--feeToken.allowance` flag value of the
+// ERC-20 fee token, and waits for the receipt. If the given nonce is not nil, the transaction is sent
+// with it and tracked by the nonce manager.
+func (p *Proposer) approveFeeToken(ctx context.Context, token *bindings.TaikoToken, nonce *uint64) error {
+	log.Info(
+		"Approving the AssignmentHook contract for fee token",
+		"allowance", p.cfg.FeeTokenAllowance,
+		"feeToken", p.cfg.FeeToken,
+	)
+
+	opts := signer.NewTransactOpts(ctx, p.proposerSigner, p.rpc.L1ChainID)
+	if nonce != nil {
+		opts.Nonce = new(big.Int).SetUint64(*nonce)
+	}
+
+	tx, err := token.Approve(opts, p.cfg.AssignmentHookAddress, p.cfg.FeeTokenAllowance)
+	if err != nil {
+		p.releaseNonce(nonce)
+		return err
+	}
+	if nonce != nil {
+		p.nonceManager.Sent(tx)
+	}
+
+	receipt, err := rpc.WaitReceipt(ctx, p.rpc.L1, tx)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Approved the AssignmentHook contract for fee token", "txHash", receipt.TxHash, "feeToken", p.cfg.FeeToken)
+
+	return nil
+}
@@ -0,0 +1,338 @@
+package forcedinclusion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/go-resty/resty/v2"
+	"github.com/taikoxyz/taiko-client/metrics"
+)
+
+// Status is the inclusion status of a forced transaction.
+type Status string
+
+// All forced transaction statuses.
+const (
+	// StatusPending means the transaction is waiting to be proposed.
+	StatusPending Status = "pending"
+	// StatusProposed means the transaction has been proposed, but not found in L2 chain yet.
+	StatusProposed Status = "proposed"
+	// StatusIncluded means the transaction has been found in L2 chain.
+	StatusIncluded Status = "included"
+	// StatusDropped means the transaction can never be included, since its sender's nonce in L2 chain
+	// has already passed the transaction's nonce, or it has been proposed too many times.
+	StatusDropped Status = "dropped"
+)
+
+// L2Client is the L2 execution engine client used to track the forced transactions.
+type L2Client interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+// InboxConfig contains all configurations for the forced inclusion inbox.
+type InboxConfig struct {
+	// URL of a queue service, which returns a JSON list of hex encoded signed L2 transactions.
+	URL string
+	// Path of a file containing a JSON list of hex encoded signed L2 transactions, which will be reloaded
+	// on change.
+	FilePath       string
+	RequestTimeout time.Duration
+	// A proposed transaction which is not found in L2 chain within this timeout will be proposed again.
+	ResubmitTimeout time.Duration
+	// A transaction which is still not found in L2 chain after being proposed again for this many times
+	// will be dropped.
+	MaxResubmissions uint64
+	// The included and dropped transactions are forgotten after this duration, they will be tracked as
+	// new transactions if they are still in the queue after that.
+	Retention time.Duration
+}
+
+// forcedTx is a forced transaction tracked by the inbox.
+type forcedTx struct {
+	tx         *types.Transaction
+	sender     common.Address
+	status     Status
+	proposedAt time.Time
+	// The sender's nonce in L2 chain, updated in each refresh.
+	senderNonce   uint64
+	resubmissions uint64
+	// When the transaction has been included or dropped.
+	finishedAt time.Time
+}
+
+// Inbox reads the signed L2 transactions from a local queue, which the proposer gives priority to
+// over the L2 transaction pool content, and tracks whether each of them has made it into L2 chain.
+type Inbox struct {
+	cfg         *InboxConfig
+	signer      types.Signer
+	txs         map[common.Hash]*forcedTx
+	queue       []common.Hash
+	fileModTime time.Time
+	mutex       sync.Mutex
+}
+
+// NewInbox creates a new Inbox instance.
+func NewInbox(cfg *InboxConfig, l2ChainID *big.Int) (*Inbox, error) {
+	if (cfg.URL == "") == (cfg.FilePath == "") {
+		return nil, errors.New("exactly one of forced inclusion queue URL and file should be set")
+	}
+	if cfg.ResubmitTimeout == 0 {
+		return nil, errors.New("empty forced inclusion resubmit timeout")
+	}
+	if cfg.Retention == 0 {
+		return nil, errors.New("empty forced inclusion retention")
+	}
+
+	return &Inbox{
+		cfg:    cfg,
+		signer: types.LatestSignerForChainID(l2ChainID),
+		txs:    make(map[common.Hash]*forcedTx),
+	}, nil
+}
+
+// Refresh fetches the new transactions from the queue, and updates the statuses of the tracked ones.
+func (i *Inbox) Refresh(ctx context.Context, cli L2Client) error {
+	txs, err := i.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for _, tx := range txs {
+		if _, ok := i.txs[tx.Hash()]; ok {
+			continue
+		}
+		sender, err := types.Sender(i.signer, tx)
+		if err != nil {
+			log.Warn("Invalid forced inclusion transaction", "hash", tx.Hash(), "error", err)
+			continue
+		}
+
+		log.Info("New forced inclusion transaction", "hash", tx.Hash(), "sender", sender, "nonce", tx.Nonce())
+		i.txs[tx.Hash()] = &forcedTx{tx: tx, sender: sender, status: StatusPending}
+		i.queue = append(i.queue, tx.Hash())
+	}
+
+	return i.track(ctx, cli)
+}
+
+// Pending returns the transactions waiting to be proposed in the queue order, whose total gas limit
+// is not greater than the given one. The transactions which can't be included at the given L2 base fee
+// or with their senders' current L2 nonces are skipped, so that they won't take the transactions list space.
+func (i *Inbox) Pending(maxGasLimit uint64, baseFee *big.Int) types.Transactions {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	var (
+		txs      types.Transactions
+		gasLimit uint64
+		nonces   = make(map[common.Address]uint64)
+	)
+	for _, hash := range i.queue {
+		forced := i.txs[hash]
+		nonce, ok := nonces[forced.sender]
+		if !ok {
+			nonce = forced.senderNonce
+		}
+
+		// The proposed transactions are expected to be included before the pending ones of the same sender.
+		if forced.status == StatusProposed && forced.tx.Nonce() == nonce {
+			nonces[forced.sender] = nonce + 1
+			continue
+		}
+		if forced.status != StatusPending || forced.tx.Nonce() != nonce || forced.tx.GasFeeCap().Cmp(baseFee) < 0 {
+			continue
+		}
+
+		if gasLimit+forced.tx.Gas() > maxGasLimit {
+			break
+		}
+		gasLimit += forced.tx.Gas()
+		nonces[forced.sender] = nonce + 1
+		txs = append(txs, forced.tx)
+	}
+
+	return txs
+}
+
+// MarkProposed marks the given transactions as proposed.
+func (i *Inbox) MarkProposed(txs types.Transactions) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for _, tx := range txs {
+		if forced, ok := i.txs[tx.Hash()]; ok && forced.status == StatusPending {
+			forced.proposedAt = time.Now()
+			i.setStatus(tx.Hash(), forced, StatusProposed)
+		}
+	}
+}
+
+// Status returns the inclusion status of the given transaction.
+func (i *Inbox) Status(hash common.Hash) (Status, bool) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	forced, ok := i.txs[hash]
+	if !ok {
+		return "", false
+	}
+	return forced.status, true
+}
+
+// track updates the statuses of the pending and proposed transactions through L2 chain, the transactions
+// which have been included or dropped are removed from the queue, but still remembered within the
+// retention, so that they won't be added again.
+func (i *Inbox) track(ctx context.Context, cli L2Client) error {
+	var queue []common.Hash
+	for _, hash := range i.queue {
+		forced := i.txs[hash]
+
+		receipt, err := cli.TransactionReceipt(ctx, hash)
+		if err != nil && !errors.Is(err, ethereum.NotFound) {
+			return err
+		}
+		if receipt != nil {
+			i.setStatus(hash, forced, StatusIncluded, "blockID", receipt.BlockNumber)
+			continue
+		}
+
+		nonce, err := cli.NonceAt(ctx, forced.sender, nil)
+		if err != nil {
+			return err
+		}
+		if nonce > forced.tx.Nonce() {
+			i.setStatus(hash, forced, StatusDropped, "reason", "nonce passed", "senderNonce", nonce)
+			continue
+		}
+		forced.senderNonce = nonce
+
+		if forced.status == StatusProposed && time.Since(forced.proposedAt) > i.cfg.ResubmitTimeout {
+			if forced.resubmissions >= i.cfg.MaxResubmissions {
+				i.setStatus(hash, forced, StatusDropped, "reason", "max resubmissions reached")
+				continue
+			}
+
+			forced.resubmissions++
+			i.setStatus(hash, forced, StatusPending, "reason", "not found in L2 chain")
+		}
+		queue = append(queue, hash)
+	}
+	i.queue = queue
+
+	// Forget the finished transactions after the retention.
+	for hash, forced := range i.txs {
+		if !forced.finishedAt.IsZero() && time.Since(forced.finishedAt) > i.cfg.Retention {
+			delete(i.txs, hash)
+		}
+	}
+
+	metrics.ProposerForcedTxsQueuedGauge.Update(int64(len(i.queue)))
+
+	return nil
+}
+
+// setStatus updates the status of the given transaction, and reports the change through logs and metrics,
+// the included and dropped transactions are finished, whose transaction bodies are released.
+func (i *Inbox) setStatus(hash common.Hash, forced *forcedTx, status Status, fields ...interface{}) {
+	fields = append([]interface{}{
+		"hash", hash,
+		"sender", forced.sender,
+		"from", forced.status,
+		"to", status,
+		"resubmissions", forced.resubmissions,
+	}, fields...)
+	forced.status = status
+
+	switch status {
+	case StatusProposed:
+		log.Info("Forced inclusion transaction status changed", fields...)
+		metrics.ProposerForcedTxsProposedCounter.Inc(1)
+	case StatusPending:
+		log.Warn("Forced inclusion transaction status changed", fields...)
+		metrics.ProposerForcedTxsResubmitCounter.Inc(1)
+	case StatusIncluded:
+		log.Info("Forced inclusion transaction status changed", fields...)
+		metrics.ProposerForcedTxsIncludedCounter.Inc(1)
+	case StatusDropped:
+		log.Warn("Forced inclusion transaction status changed", fields...)
+		metrics.ProposerForcedTxsDroppedCounter.Inc(1)
+	}
+
+	if status == StatusIncluded || status == StatusDropped {
+		forced.tx = nil
+		forced.finishedAt = time.Now()
+	}
+}
+
+// fetch fetches the transactions from the queue service or the queue file.
+func (i *Inbox) fetch(ctx context.Context) (types.Transactions, error) {
+	var encoded []hexutil.Bytes
+
+	if i.cfg.URL != "" {
+		ctxTimeout, cancel := context.WithTimeout(ctx, i.cfg.RequestTimeout)
+		defer cancel()
+
+		resp, err := resty.New().R().
+			SetContext(ctxTimeout).
+			SetHeader("Accept", "application/json").
+			SetResult(&encoded).
+			Get(i.cfg.URL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch forced inclusion queue: %w", err)
+		}
+		if !resp.IsSuccess() {
+			return nil, fmt.Errorf("unsuccessful forced inclusion queue response %d", resp.StatusCode())
+		}
+
+		return decodeTxs(encoded)
+	}
+
+	info, err := os.Stat(i.cfg.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat forced inclusion file: %w", err)
+	}
+	// Only reload the file when it has been changed, the loaded transactions are tracked already.
+	if info.ModTime().Equal(i.fileModTime) {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(i.cfg.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read forced inclusion file: %w", err)
+	}
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, fmt.Errorf("failed to decode forced inclusion file: %w", err)
+	}
+	i.fileModTime = info.ModTime()
+
+	return decodeTxs(encoded)
+}
+
+// decodeTxs decodes the given binary encoded signed transactions.
+func decodeTxs(encoded []hexutil.Bytes) (types.Transactions, error) {
+	txs := make(types.Transactions, 0, len(encoded))
+	for _, b := range encoded {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(b); err != nil {
+			return nil, fmt.Errorf("failed to decode forced inclusion transaction: %w", err)
+		}
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
@@ -0,0 +1,226 @@
+package forcedinclusion
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var testChainID = big.NewInt(167001)
+
+type testL2Client struct {
+	receipts map[common.Hash]*types.Receipt
+	nonces   map[common.Address]uint64
+}
+
+func (c *testL2Client) TransactionReceipt(_ context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if receipt, ok := c.receipts[txHash]; ok {
+		return receipt, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func (c *testL2Client) NonceAt(_ context.Context, account common.Address, _ *big.Int) (uint64, error) {
+	return c.nonces[account], nil
+}
+
+func newTestForcedTxs(t *testing.T, count int) types.Transactions {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	var txs types.Transactions
+	for i := 0; i < count; i++ {
+		tx, err := types.SignNewTx(key, types.LatestSignerForChainID(testChainID), &types.DynamicFeeTx{
+			ChainID:   testChainID,
+			Nonce:     uint64(i),
+			Gas:       21000,
+			GasFeeCap: common.Big1,
+			GasTipCap: common.Big1,
+		})
+		require.Nil(t, err)
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
+
+func hashes(txs types.Transactions) []common.Hash {
+	hashes := make([]common.Hash, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash())
+	}
+	return hashes
+}
+
+func writeTestForcedTxs(t *testing.T, path string, txs types.Transactions) {
+	var encoded []hexutil.Bytes
+	for _, tx := range txs {
+		b, err := tx.MarshalBinary()
+		require.Nil(t, err)
+		encoded = append(encoded, b)
+	}
+
+	data, err := json.Marshal(encoded)
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(path, data, 0o600))
+}
+
+func TestNewInboxErr(t *testing.T) {
+	_, err := NewInbox(&InboxConfig{ResubmitTimeout: time.Minute}, testChainID)
+	require.ErrorContains(t, err, "exactly one of")
+	_, err = NewInbox(&InboxConfig{URL: "http://localhost:9000", FilePath: "txs.json"}, testChainID)
+	require.ErrorContains(t, err, "exactly one of")
+	_, err = NewInbox(&InboxConfig{FilePath: "txs.json"}, testChainID)
+	require.ErrorContains(t, err, "empty forced inclusion resubmit timeout")
+	_, err = NewInbox(&InboxConfig{FilePath: "txs.json", ResubmitTimeout: time.Minute}, testChainID)
+	require.ErrorContains(t, err, "empty forced inclusion retention")
+}
+
+func TestInbox(t *testing.T) {
+	var (
+		path = filepath.Join(t.TempDir(), "forced_txs.json")
+		txs  = newTestForcedTxs(t, 3)
+		cli  = &testL2Client{receipts: map[common.Hash]*types.Receipt{}, nonces: map[common.Address]uint64{}}
+	)
+	writeTestForcedTxs(t, path, txs)
+
+	inbox, err := NewInbox(&InboxConfig{FilePath: path, ResubmitTimeout: time.Hour, Retention: time.Hour}, testChainID)
+	require.Nil(t, err)
+
+	require.Nil(t, inbox.Refresh(context.Background(), cli))
+	require.Equal(t, hashes(txs), hashes(inbox.Pending(21000*3, common.Big1)))
+	require.Equal(t, hashes(txs[:2]), hashes(inbox.Pending(21000*2, common.Big1)))
+
+	// Proposed transactions should not be proposed again before the resubmit timeout.
+	inbox.MarkProposed(txs[:2])
+	require.Equal(t, hashes(types.Transactions{txs[2]}), hashes(inbox.Pending(21000*3, common.Big1)))
+	status, ok := inbox.Status(txs[0].Hash())
+	require.True(t, ok)
+	require.Equal(t, StatusProposed, status)
+
+	// The first transaction is included, and the second one is replaced by another transaction.
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), txs[0])
+	require.Nil(t, err)
+	cli.receipts[txs[0].Hash()] = &types.Receipt{BlockNumber: common.Big1}
+	cli.nonces[sender] = 2
+
+	require.Nil(t, inbox.Refresh(context.Background(), cli))
+	status, _ = inbox.Status(txs[0].Hash())
+	require.Equal(t, StatusIncluded, status)
+	status, _ = inbox.Status(txs[1].Hash())
+	require.Equal(t, StatusDropped, status)
+	require.Equal(t, hashes(types.Transactions{txs[2]}), hashes(inbox.Pending(21000*3, common.Big1)))
+
+	// Tracked transactions should not be added again after reloading.
+	writeTestForcedTxs(t, path, txs)
+	require.Nil(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	require.Nil(t, inbox.Refresh(context.Background(), cli))
+	require.Equal(t, hashes(types.Transactions{txs[2]}), hashes(inbox.Pending(21000*3, common.Big1)))
+
+	_, ok = inbox.Status(common.Hash{})
+	require.False(t, ok)
+}
+
+func TestInboxResubmit(t *testing.T) {
+	var (
+		path = filepath.Join(t.TempDir(), "forced_txs.json")
+		txs  = newTestForcedTxs(t, 1)
+		cli  = &testL2Client{receipts: map[common.Hash]*types.Receipt{}, nonces: map[common.Address]uint64{}}
+	)
+	writeTestForcedTxs(t, path, txs)
+
+	inbox, err := NewInbox(&InboxConfig{
+		FilePath:         path,
+		ResubmitTimeout:  time.Nanosecond,
+		MaxResubmissions: 1,
+		Retention:        time.Hour,
+	}, testChainID)
+	require.Nil(t, err)
+
+	require.Nil(t, inbox.Refresh(context.Background(), cli))
+	inbox.MarkProposed(txs)
+	require.Empty(t, inbox.Pending(21000, common.Big1))
+
+	time.Sleep(time.Millisecond)
+	require.Nil(t, inbox.Refresh(context.Background(), cli))
+	require.Equal(t, hashes(txs), hashes(inbox.Pending(21000, common.Big1)))
+
+	// The transaction is dropped once the max resubmissions is reached.
+	inbox.MarkProposed(txs)
+	time.Sleep(time.Millisecond)
+	require.Nil(t, inbox.Refresh(context.Background(), cli))
+	require.Empty(t, inbox.Pending(21000, common.Big1))
+	status, _ := inbox.Status(txs[0].Hash())
+	require.Equal(t, StatusDropped, status)
+}
+
+func TestInboxRetention(t *testing.T) {
+	var (
+		path = filepath.Join(t.TempDir(), "forced_txs.json")
+		txs  = newTestForcedTxs(t, 2)
+		cli  = &testL2Client{receipts: map[common.Hash]*types.Receipt{}, nonces: map[common.Address]uint64{}}
+	)
+	writeTestForcedTxs(t, path, txs)
+
+	inbox, err := NewInbox(&InboxConfig{
+		FilePath:        path,
+		ResubmitTimeout: time.Hour,
+		Retention:       50 * time.Millisecond,
+	}, testChainID)
+	require.Nil(t, err)
+
+	require.Nil(t, inbox.Refresh(context.Background(), cli))
+	inbox.MarkProposed(txs[:1])
+	cli.receipts[txs[0].Hash()] = &types.Receipt{BlockNumber: common.Big1}
+
+	// The included transaction is finished, and forgotten after the retention.
+	require.Nil(t, inbox.Refresh(context.Background(), cli))
+	status, ok := inbox.Status(txs[0].Hash())
+	require.True(t, ok)
+	require.Equal(t, StatusIncluded, status)
+
+	time.Sleep(100 * time.Millisecond)
+	require.Nil(t, inbox.Refresh(context.Background(), cli))
+	_, ok = inbox.Status(txs[0].Hash())
+	require.False(t, ok)
+	status, _ = inbox.Status(txs[1].Hash())
+	require.Equal(t, StatusPending, status)
+	require.Len(t, inbox.txs, 1)
+}
+
+func TestInboxPendingNotIncludable(t *testing.T) {
+	var (
+		path = filepath.Join(t.TempDir(), "forced_txs.json")
+		txs  = newTestForcedTxs(t, 3)
+		cli  = &testL2Client{receipts: map[common.Hash]*types.Receipt{}, nonces: map[common.Address]uint64{}}
+	)
+	// The first transaction is not in the queue, so all the others have future nonces.
+	writeTestForcedTxs(t, path, txs[1:])
+
+	inbox, err := NewInbox(&InboxConfig{FilePath: path, ResubmitTimeout: time.Hour, Retention: time.Hour}, testChainID)
+	require.Nil(t, err)
+
+	require.Nil(t, inbox.Refresh(context.Background(), cli))
+	require.Empty(t, inbox.Pending(21000*3, common.Big1))
+
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), txs[0])
+	require.Nil(t, err)
+	cli.nonces[sender] = 1
+
+	require.Nil(t, inbox.Refresh(context.Background(), cli))
+	require.Equal(t, hashes(txs[1:]), hashes(inbox.Pending(21000*3, common.Big1)))
+
+	// The fee caps are lower than the L2 base fee.
+	require.Empty(t, inbox.Pending(21000*3, common.Big2))
+}
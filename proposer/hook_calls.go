@@ -0,0 +1,144 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+// HookCallTemplate is an additional hook call attached to every proposal. Its data is a hex encoded
+// ABI-encoded data template, the following placeholders will be replaced by the 32 bytes ABI-encoded
+// words of the current proposal: {{.Proposer}}, {{.AssignedProver}} and {{.Tip}}.
+type HookCallTemplate struct {
+	Hook common.Address
+	Data *template.Template
+}
+
+// hookDataTemplateValues are the values of a proposal, which can be used in a hook data template.
+type hookDataTemplateValues struct {
+	Proposer       string
+	AssignedProver string
+	Tip            string
+}
+
+// ParseHookCallTemplate parses a hook call template in `<address>:<hex data template>` format.
+func ParseHookCallTemplate(s string) (*HookCallTemplate, error) {
+	hook, data, found := strings.Cut(strings.TrimSpace(s), ":")
+	if !found || !common.IsHexAddress(hook) {
+		return nil, fmt.Errorf("invalid hook call: %s", s)
+	}
+
+	tmpl, err := template.New(hook).Option("missingkey=error").Parse(strings.TrimPrefix(data, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid hook call data template: %w", err)
+	}
+
+	hookCall := &HookCallTemplate{Hook: common.HexToAddress(hook), Data: tmpl}
+
+	// Make sure the template can be rendered to valid bytes.
+	if _, err := hookCall.Render(common.Address{}, common.Address{}, common.Big0); err != nil {
+		return nil, err
+	}
+
+	return hookCall, nil
+}
+
+// Render renders the hook call with the given proposal values.
+func (h *HookCallTemplate) Render(
+	proposer common.Address,
+	assignedProver common.Address,
+	tip *big.Int,
+) (*encoding.HookCall, error) {
+	var buf bytes.Buffer
+	if err := h.Data.Execute(&buf, &hookDataTemplateValues{
+		Proposer:       abiWord(proposer.Bytes()),
+		AssignedProver: abiWord(assignedProver.Bytes()),
+		Tip:            abiWord(tip.Bytes()),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to render hook call data: %w", err)
+	}
+
+	data, err := hexutil.Decode("0x" + buf.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid hook call data: %w", err)
+	}
+
+	return &encoding.HookCall{Hook: h.Hook, Data: data}, nil
+}
+
+// abiWord returns the hex encoded 32 bytes left padded ABI word of the given bytes.
+func abiWord(b []byte) string {
+	return common.Bytes2Hex(common.LeftPadBytes(b, 32))
+}
+
+// buildHookCalls builds the hook calls of a proposal, the AssignmentHook call always comes first, so
+// the tip will be paid before the additional hooks are called.
+func (p *Proposer) buildHookCalls(
+	assignment *encoding.ProverAssignment,
+	assignedProver common.Address,
+	tip *big.Int,
+) ([]encoding.HookCall, error) {
+	hookInputData, err := encoding.EncodeAssignmentHookInput(&encoding.AssignmentHookInput{
+		Assignment: assignment,
+		Tip:        tip,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hookCalls := []encoding.HookCall{{Hook: p.cfg.AssignmentHookAddress, Data: hookInputData}}
+	for _, t := range p.cfg.HookCallTemplates {
+		hookCall, err := t.Render(p.proposerAddress, assignedProver, tip)
+		if err != nil {
+			return nil, err
+		}
+		hookCalls = append(hookCalls, *hookCall)
+	}
+
+	return hookCalls, nil
+}
+
+// proposerTip calculates the tip paid to the L1 block builder for proposing the given transactions list,
+// which is a fixed tip plus a percentage of the transactions list's priority fees at the current L2 base fee.
+func (p *Proposer) proposerTip(ctx context.Context, txListBytes []byte) (*big.Int, error) {
+	tip := new(big.Int)
+	if p.cfg.FixedTip != nil {
+		tip.Set(p.cfg.FixedTip)
+	}
+	if p.cfg.TipPriorityFeePercentage == 0 {
+		return tip, nil
+	}
+
+	rlpBytes, err := p.txListCodec.Decode(txListBytes)
+	if err != nil {
+		return nil, err
+	}
+	var txs types.Transactions
+	if err := rlp.DecodeBytes(rlpBytes, &txs); err != nil {
+		return nil, err
+	}
+
+	head, err := p.rpc.L2.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return tip.Add(tip, priorityFeesShare(txs, head.BaseFee, p.cfg.TipPriorityFeePercentage)), nil
+}
+
+// priorityFeesShare returns the given percentage of the transactions priority fees at the given base fee,
+// transactions gas limits are used as the gas used estimation.
+func priorityFeesShare(txs types.Transactions, baseFee *big.Int, percentage uint64) *big.Int {
+	fees := estimateRevenue(txs, baseFee, 0)
+
+	return fees.Div(fees.Mul(fees, new(big.Int).SetUint64(percentage)), big.NewInt(100))
+}
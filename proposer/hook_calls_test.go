@@ -0,0 +1,78 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func TestParseHookCallTemplate(t *testing.T) {
+	var (
+		hook     = common.BigToAddress(common.Big1)
+		proposer = common.BigToAddress(common.Big2)
+		prover   = common.BigToAddress(common.Big3)
+	)
+
+	hookCall, err := ParseHookCallTemplate(hook.Hex() + ":0x12345678{{.Proposer}}{{.AssignedProver}}{{.Tip}}")
+	require.Nil(t, err)
+	require.Equal(t, hook, hookCall.Hook)
+
+	rendered, err := hookCall.Render(proposer, prover, big.NewInt(256))
+	require.Nil(t, err)
+	require.Equal(t, hook, rendered.Hook)
+	require.Equal(t, 4+32*3, len(rendered.Data))
+	require.Equal(t, []byte{0x12, 0x34, 0x56, 0x78}, rendered.Data[:4])
+	require.Equal(t, common.LeftPadBytes(proposer.Bytes(), 32), rendered.Data[4:36])
+	require.Equal(t, common.LeftPadBytes(prover.Bytes(), 32), rendered.Data[36:68])
+	require.Equal(t, common.LeftPadBytes([]byte{1, 0}, 32), rendered.Data[68:])
+
+	// Static data.
+	hookCall, err = ParseHookCallTemplate(hook.Hex() + ":0xabcd")
+	require.Nil(t, err)
+	rendered, err = hookCall.Render(proposer, prover, common.Big0)
+	require.Nil(t, err)
+	require.Equal(t, []byte{0xab, 0xcd}, rendered.Data)
+
+	for _, s := range []string{
+		"0xabcd",
+		"0x123:0xabcd",
+		hook.Hex() + ":0xabc",
+		hook.Hex() + ":0x{{.Unknown}}",
+		hook.Hex() + ":0x{{.Tip",
+	} {
+		_, err := ParseHookCallTemplate(s)
+		require.NotNil(t, err, s)
+	}
+}
+
+func TestBuildHookCalls(t *testing.T) {
+	extraHook, err := ParseHookCallTemplate(common.BigToAddress(common.Big2).Hex() + ":0x{{.Tip}}")
+	require.Nil(t, err)
+
+	p := &Proposer{
+		cfg: &Config{
+			AssignmentHookAddress: common.BigToAddress(common.Big1),
+			HookCallTemplates:     []*HookCallTemplate{extraHook},
+		},
+	}
+
+	hookCalls, err := p.buildHookCalls(&encoding.ProverAssignment{}, common.Address{}, common.Big1)
+	require.Nil(t, err)
+	require.Len(t, hookCalls, 2)
+	require.Equal(t, p.cfg.AssignmentHookAddress, hookCalls[0].Hook)
+	require.Equal(t, extraHook.Hook, hookCalls[1].Hook)
+	require.Equal(t, common.LeftPadBytes([]byte{1}, 32), hookCalls[1].Data)
+}
+
+func TestPriorityFeesShare(t *testing.T) {
+	txs := types.Transactions{
+		types.NewTx(&types.DynamicFeeTx{Gas: 21000, GasTipCap: big.NewInt(10), GasFeeCap: big.NewInt(200)}),
+	}
+
+	require.Equal(t, big.NewInt(21000*10/2), priorityFeesShare(txs, big.NewInt(100), 50))
+	require.Equal(t, common.Big0.Int64(), priorityFeesShare(txs, big.NewInt(100), 0).Int64())
+}
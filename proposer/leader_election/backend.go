@@ -0,0 +1,191 @@
+package election
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+)
+
+var lockRetryInterval = 50 * time.Millisecond
+
+// Lease is the leadership lease shared by all redundant proposer instances.
+type Lease struct {
+	Holder string    `json:"holder"`
+	Term   uint64    `json:"term"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// Backend is the lock backend storing the leadership lease.
+type Backend interface {
+	// TryAcquire renews the lease if it is held by the given holder, or takes it over if it has expired,
+	// the current lease is always returned, no matter the given holder holds it or not.
+	TryAcquire(ctx context.Context, holder string, ttl time.Duration) (*Lease, error)
+	// Release releases the lease if it is held by the given holder.
+	Release(ctx context.Context, holder string) error
+}
+
+// acquire returns the new lease after the given holder trying to acquire the given current lease.
+func acquire(current *Lease, holder string, ttl time.Duration, now time.Time) *Lease {
+	if current == nil {
+		return &Lease{Holder: holder, Term: 1, Expiry: now.Add(ttl)}
+	}
+	if current.Holder == holder {
+		return &Lease{Holder: holder, Term: current.Term, Expiry: now.Add(ttl)}
+	}
+	if now.After(current.Expiry) {
+		return &Lease{Holder: holder, Term: current.Term + 1, Expiry: now.Add(ttl)}
+	}
+
+	return current
+}
+
+// MemoryBackend is an in-process Backend implementation, which is mainly used for testing.
+type MemoryBackend struct {
+	lease *Lease
+	mutex sync.Mutex
+}
+
+// NewMemoryBackend creates a new MemoryBackend instance.
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{}
+}
+
+// TryAcquire implements the Backend interface.
+func (b *MemoryBackend) TryAcquire(_ context.Context, holder string, ttl time.Duration) (*Lease, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.lease = acquire(b.lease, holder, ttl, time.Now())
+
+	lease := *b.lease
+	return &lease, nil
+}
+
+// Release implements the Backend interface.
+func (b *MemoryBackend) Release(_ context.Context, holder string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.lease != nil && b.lease.Holder == holder {
+		b.lease.Expiry = time.Time{}
+	}
+
+	return nil
+}
+
+// FileBackend is a Backend implementation storing the lease in a file, which can be put on a shared storage
+// supporting flock for the proposer instances running on different machines.
+type FileBackend struct {
+	path string
+}
+
+// NewFileBackend creates a new FileBackend instance.
+func NewFileBackend(path string) (*FileBackend, error) {
+	if path == "" {
+		return nil, errors.New("empty lease file path")
+	}
+
+	return &FileBackend{path: path}, nil
+}
+
+// TryAcquire implements the Backend interface.
+func (b *FileBackend) TryAcquire(ctx context.Context, holder string, ttl time.Duration) (*Lease, error) {
+	var lease *Lease
+	if err := b.withLock(ctx, func() error {
+		current, err := b.read()
+		if err != nil {
+			return err
+		}
+
+		if lease = acquire(current, holder, ttl, time.Now()); lease.Holder != holder {
+			return nil
+		}
+
+		return b.write(lease)
+	}); err != nil {
+		return nil, err
+	}
+
+	return lease, nil
+}
+
+// Release implements the Backend interface.
+func (b *FileBackend) Release(ctx context.Context, holder string) error {
+	return b.withLock(ctx, func() error {
+		current, err := b.read()
+		if err != nil {
+			return err
+		}
+		if current == nil || current.Holder != holder {
+			return nil
+		}
+
+		current.Expiry = time.Time{}
+		return b.write(current)
+	})
+}
+
+// withLock runs the given function while holding an exclusive flock on the lock file, the flock is released
+// by the kernel once the holder crashes, so that there is never a stale lock to clean up.
+func (b *FileBackend) withLock(ctx context.Context, f func() error) error {
+	lock, err := os.OpenFile(b.path+".lock", os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open lease lock file: %w", err)
+	}
+	// Closing the lock file releases the flock.
+	defer lock.Close()
+
+	for {
+		err := syscall.Flock(int(lock.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+		if err == nil {
+			return f()
+		}
+		if !errors.Is(err, syscall.EWOULDBLOCK) && !errors.Is(err, syscall.EINTR) {
+			return fmt.Errorf("failed to lock lease lock file: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(lockRetryInterval):
+		}
+	}
+}
+
+// read reads the lease from the lease file, returns nil if the file does not exist.
+func (b *FileBackend) read() (*Lease, error) {
+	data, err := os.ReadFile(b.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read lease file: %w", err)
+	}
+
+	lease := new(Lease)
+	if err := json.Unmarshal(data, lease); err != nil {
+		return nil, fmt.Errorf("failed to decode lease file: %w", err)
+	}
+
+	return lease, nil
+}
+
+// write writes the given lease to the lease file atomically.
+func (b *FileBackend) write(lease *Lease) error {
+	data, err := json.Marshal(lease)
+	if err != nil {
+		return err
+	}
+
+	tmp := b.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write lease file: %w", err)
+	}
+
+	return os.Rename(tmp, b.path)
+}
@@ -0,0 +1,150 @@
+package election
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// NonceReader reads the nonces of the proposer account from L1.
+type NonceReader interface {
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// Config contains all configurations for the proposer leader election.
+type Config struct {
+	// Unique ID of this proposer instance.
+	ID       string
+	LeaseTTL time.Duration
+	Backend  Backend
+}
+
+// Elector makes sure only one of the redundant proposer instances sharing the same proposer account is
+// proposing. The leader keeps renewing the lease through the lock backend, and a standby takes the lease
+// over once it expires.
+//
+// To avoid racing with the in-flight transactions sent by the previous leader, a new leader is fenced by
+// the L1 pending nonce of the proposer account at the time it takes over, and only starts proposing once
+// the L1 confirmed nonce has caught up with it, or the lease TTL has passed.
+type Elector struct {
+	cfg     *Config
+	cli     NonceReader
+	account common.Address
+
+	lease    *Lease
+	fence    *uint64
+	fencedAt time.Time
+	mutex    sync.RWMutex
+}
+
+// New creates a new Elector instance.
+func New(cfg *Config, cli NonceReader, account common.Address) (*Elector, error) {
+	if cfg.ID == "" {
+		return nil, errors.New("empty proposer instance ID")
+	}
+	if cfg.LeaseTTL == 0 {
+		return nil, errors.New("empty leader lease TTL")
+	}
+	if cfg.Backend == nil {
+		return nil, errors.New("empty leader election backend")
+	}
+
+	return &Elector{cfg: cfg, cli: cli, account: account}, nil
+}
+
+// IsLeader returns whether this instance is the active leader, a leader which failed to renew its lease
+// in time steps down before the lease expires, to leave a safety margin for the clock drift.
+func (e *Elector) IsLeader() bool {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	return e.lease != nil && e.fence == nil && time.Now().Before(e.lease.Expiry.Add(-e.renewInterval()))
+}
+
+// Start keeps renewing or trying to acquire the lease, until the given context is done, then the lease
+// will be released, so that a standby can take it over immediately.
+func (e *Elector) Start(ctx context.Context) {
+	ticker := time.NewTicker(e.renewInterval())
+	defer ticker.Stop()
+
+	for {
+		if err := e.Renew(ctx); err != nil {
+			log.Warn("Failed to renew proposer leader lease", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			ctxTimeout, cancel := context.WithTimeout(context.Background(), e.renewInterval())
+			defer cancel()
+			if err := e.cfg.Backend.Release(ctxTimeout, e.cfg.ID); err != nil {
+				log.Warn("Failed to release proposer leader lease", "error", err)
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Renew renews or tries to acquire the lease, and updates the leadership of this instance.
+func (e *Elector) Renew(ctx context.Context) error {
+	lease, err := e.cfg.Backend.TryAcquire(ctx, e.cfg.ID, e.cfg.LeaseTTL)
+	if err != nil {
+		return err
+	}
+
+	if lease.Holder != e.cfg.ID {
+		if e.lease != nil {
+			log.Warn("Proposer leadership lost", "leader", lease.Holder, "term", lease.Term)
+		}
+		e.setLeadership(nil, nil)
+		return nil
+	}
+
+	fence := e.fence
+	if e.lease == nil || e.lease.Term != lease.Term {
+		pending, err := e.cli.PendingNonceAt(ctx, e.account)
+		if err != nil {
+			return err
+		}
+		log.Info("Proposer leadership acquired", "id", e.cfg.ID, "term", lease.Term, "fenceNonce", pending)
+
+		fence = &pending
+		e.fencedAt = time.Now()
+	}
+
+	if fence != nil {
+		confirmed, err := e.cli.NonceAt(ctx, e.account, nil)
+		if err != nil {
+			e.setLeadership(lease, fence)
+			return err
+		}
+		if confirmed >= *fence || time.Since(e.fencedAt) > e.cfg.LeaseTTL {
+			log.Info("Proposer leadership activated", "term", lease.Term, "nonce", confirmed, "fenceNonce", *fence)
+			fence = nil
+		}
+	}
+
+	e.setLeadership(lease, fence)
+
+	return nil
+}
+
+// setLeadership updates the held lease and the nonce fence.
+func (e *Elector) setLeadership(lease *Lease, fence *uint64) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.lease = lease
+	e.fence = fence
+}
+
+// renewInterval returns the interval to renew the lease.
+func (e *Elector) renewInterval() time.Duration {
+	return e.cfg.LeaseTTL / 3
+}
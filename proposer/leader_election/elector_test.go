@@ -0,0 +1,123 @@
+package election
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+type testNonceReader struct {
+	confirmed uint64
+	pending   uint64
+}
+
+func (r *testNonceReader) NonceAt(_ context.Context, _ common.Address, _ *big.Int) (uint64, error) {
+	return r.confirmed, nil
+}
+
+func (r *testNonceReader) PendingNonceAt(_ context.Context, _ common.Address) (uint64, error) {
+	return r.pending, nil
+}
+
+func newTestElectors(t *testing.T, backend Backend, cli NonceReader, ttl time.Duration) (*Elector, *Elector) {
+	a, err := New(&Config{ID: "a", LeaseTTL: ttl, Backend: backend}, cli, common.Address{})
+	require.Nil(t, err)
+	b, err := New(&Config{ID: "b", LeaseTTL: ttl, Backend: backend}, cli, common.Address{})
+	require.Nil(t, err)
+
+	return a, b
+}
+
+func TestNewErr(t *testing.T) {
+	_, err := New(&Config{LeaseTTL: time.Second, Backend: NewMemoryBackend()}, nil, common.Address{})
+	require.ErrorContains(t, err, "empty proposer instance ID")
+	_, err = New(&Config{ID: "a", Backend: NewMemoryBackend()}, nil, common.Address{})
+	require.ErrorContains(t, err, "empty leader lease TTL")
+	_, err = New(&Config{ID: "a", LeaseTTL: time.Second}, nil, common.Address{})
+	require.ErrorContains(t, err, "empty leader election backend")
+}
+
+func TestElectorFailover(t *testing.T) {
+	for name, backend := range map[string]func() Backend{
+		"memory": func() Backend { return NewMemoryBackend() },
+		"file": func() Backend {
+			b, err := NewFileBackend(filepath.Join(t.TempDir(), "lease.json"))
+			require.Nil(t, err)
+			return b
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				cli  = &testNonceReader{}
+				a, b = newTestElectors(t, backend(), cli, 300*time.Millisecond)
+			)
+
+			require.Nil(t, a.Renew(ctx))
+			require.Nil(t, b.Renew(ctx))
+			require.True(t, a.IsLeader())
+			require.False(t, b.IsLeader())
+
+			// The leader stops renewing, and the standby takes over after the lease expires.
+			time.Sleep(400 * time.Millisecond)
+			require.False(t, a.IsLeader())
+			require.Nil(t, b.Renew(ctx))
+			require.True(t, b.IsLeader())
+			require.Nil(t, a.Renew(ctx))
+			require.False(t, a.IsLeader())
+
+			// The leader releases the lease, and the standby takes over immediately.
+			require.Nil(t, b.cfg.Backend.Release(ctx, "b"))
+			require.Nil(t, a.Renew(ctx))
+			require.True(t, a.IsLeader())
+			require.Nil(t, b.Renew(ctx))
+			require.False(t, b.IsLeader())
+		})
+	}
+}
+
+func TestElectorNonceFence(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		cli  = &testNonceReader{confirmed: 1, pending: 3}
+		a, _ = newTestElectors(t, NewMemoryBackend(), cli, time.Minute)
+	)
+
+	// The in-flight transactions of the previous leader have not been confirmed yet.
+	require.Nil(t, a.Renew(ctx))
+	require.False(t, a.IsLeader())
+
+	// New transactions sent after taking over don't move the fence.
+	cli.pending = 5
+	require.Nil(t, a.Renew(ctx))
+	require.False(t, a.IsLeader())
+
+	cli.confirmed = 3
+	require.Nil(t, a.Renew(ctx))
+	require.True(t, a.IsLeader())
+}
+
+func TestFileBackendLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.json")
+	a, err := NewFileBackend(path)
+	require.Nil(t, err)
+	b, err := NewFileBackend(path)
+	require.Nil(t, err)
+
+	// The lock can't be taken while it is held by another backend.
+	require.Nil(t, a.withLock(context.Background(), func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		require.ErrorIs(t, b.withLock(ctx, func() error { return nil }), context.DeadlineExceeded)
+		return nil
+	}))
+
+	// The lock is released once the holder returns.
+	require.Nil(t, b.withLock(context.Background(), func() error { return nil }))
+}
@@ -0,0 +1,393 @@
+package noncemanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+)
+
+// actionType represents an action to take on an in-flight nonce.
+type actionType uint8
+
+// All actions which can be taken on an in-flight nonce.
+const (
+	// Send the tracked transaction again, when it is neither mined nor in the L1 mempool.
+	actionRebroadcast actionType = iota
+	// Replace the tracked transaction with a bumped gas tip cap, when it is stuck.
+	actionReplace
+	// Fill a nonce hole with a cancel transaction, when nothing has been sent with that nonce.
+	actionCancel
+)
+
+// String implements the fmt.Stringer interface.
+func (t actionType) String() string {
+	switch t {
+	case actionRebroadcast:
+		return "rebroadcast"
+	case actionReplace:
+		return "replace"
+	default:
+		return "cancel"
+	}
+}
+
+// action is an action to take on an in-flight nonce.
+type action struct {
+	kind  actionType
+	nonce uint64
+	tx    *types.Transaction
+}
+
+// inFlightTx is a sent transaction which has not been mined yet.
+type inFlightTx struct {
+	tx     *types.Transaction
+	sentAt time.Time
+}
+
+// NonceManager hands out nonces of the proposer account, and keeps track of all in-flight transactions
+// during the whole lifetime of the proposer. Before each proposing epoch, it reconciles its state with L1,
+// rebroadcasts the lost transactions, replaces the stuck ones with bumped gas tip caps, and fills the nonce
+// holes with cancel transactions, so that one failed transaction will never wedge the account. After a
+// restart, the transactions found in the L1 mempool are adopted as in-flight ones.
+type NonceManager struct {
+	rpc           *rpc.Client
+	signer        signer.Signer
+	address       common.Address
+	stuckTimeout  time.Duration
+	tipMultiplier *big.Int
+	maxGasTipCap  *big.Int
+
+	mutex    sync.Mutex
+	synced   bool
+	next     uint64
+	inFlight map[uint64]*inFlightTx
+	holes    map[uint64]struct{}
+}
+
+// New creates a new NonceManager instance.
+func New(
+	cli *rpc.Client,
+	s signer.Signer,
+	stuckTimeout time.Duration,
+	tipMultiplier uint64,
+	maxGasTipCap *big.Int,
+) *NonceManager {
+	return &NonceManager{
+		rpc:           cli,
+		signer:        s,
+		address:       s.Address(),
+		stuckTimeout:  stuckTimeout,
+		tipMultiplier: new(big.Int).SetUint64(tipMultiplier),
+		maxGasTipCap:  maxGasTipCap,
+		inFlight:      make(map[uint64]*inFlightTx),
+		holes:         make(map[uint64]struct{}),
+	}
+}
+
+// Sync reconciles the in-flight transactions with L1, and fixes the nonce holes and stuck transactions,
+// it should be called before handing out nonces in each proposing epoch.
+func (m *NonceManager) Sync(ctx context.Context) error {
+	confirmed, err := m.rpc.L1.NonceAt(ctx, m.address, nil)
+	if err != nil {
+		return err
+	}
+	pending, err := m.rpc.L1.PendingNonceAt(ctx, m.address)
+	if err != nil {
+		return err
+	}
+	content, err := rpc.ContentFrom(ctx, m.rpc.L1RawRPC, m.address)
+	if err != nil {
+		return err
+	}
+	mempool, err := mempoolTxsByNonce(content)
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.synced {
+		m.next = pending
+		m.synced = true
+	}
+
+	actions := m.check(confirmed, mempool, time.Now())
+
+	log.Info(
+		"Proposer nonces synced",
+		"confirmed", confirmed,
+		"pending", pending,
+		"next", m.next,
+		"inFlight", len(m.inFlight),
+		"actions", len(actions),
+	)
+
+	for _, a := range actions {
+		if err := m.execute(ctx, a); err != nil {
+			log.Warn("Failed to fix in-flight nonce", "action", a.kind, "nonce", a.nonce, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// Next reserves and returns the next nonce, the caller must then call either `Sent` or `Release`.
+func (m *NonceManager) Next() uint64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	nonce := m.next
+	m.next++
+
+	return nonce
+}
+
+// Sent records a sent transaction as in-flight.
+func (m *NonceManager) Sent(tx *types.Transaction) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.inFlight[tx.Nonce()] = &inFlightTx{tx: tx, sentAt: time.Now()}
+	delete(m.holes, tx.Nonce())
+	if tx.Nonce() >= m.next {
+		m.next = tx.Nonce() + 1
+	}
+}
+
+// Release marks a reserved nonce as a hole, when nothing has been sent with it, the hole will be filled
+// in the next `Sync` call.
+func (m *NonceManager) Release(nonce uint64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.inFlight[nonce]; ok {
+		return
+	}
+
+	log.Warn("Proposer nonce released without a transaction sent", "nonce", nonce)
+	m.holes[nonce] = struct{}{}
+}
+
+// check prunes the confirmed transactions, and returns the actions to take on the in-flight nonces.
+func (m *NonceManager) check(
+	confirmed uint64,
+	mempool map[uint64]*types.Transaction,
+	now time.Time,
+) []*action {
+	for nonce := range m.inFlight {
+		if nonce < confirmed {
+			delete(m.inFlight, nonce)
+		}
+	}
+	for nonce := range m.holes {
+		if nonce < confirmed {
+			delete(m.holes, nonce)
+		}
+	}
+	if m.next < confirmed {
+		m.next = confirmed
+	}
+
+	var actions []*action
+	for nonce := confirmed; nonce < m.next; nonce++ {
+		tracked, ok := m.inFlight[nonce]
+		if !ok {
+			if mempool[nonce] == nil {
+				actions = append(actions, &action{kind: actionCancel, nonce: nonce})
+				continue
+			}
+
+			// Adopt the transaction found in mempool, even if its nonce has been released, since a cancel
+			// transaction can't replace a pending blob transaction.
+			m.inFlight[nonce] = &inFlightTx{tx: mempool[nonce], sentAt: now}
+			delete(m.holes, nonce)
+			continue
+		}
+
+		// A blob transaction adopted from mempool carries no sidecar, so it can neither be replaced nor
+		// rebroadcast, keep waiting until it is mined, or cancel it once it has been dropped.
+		if tracked.tx.Type() == types.BlobTxType && tracked.tx.BlobTxSidecar() == nil {
+			if mempool[nonce] == nil {
+				delete(m.inFlight, nonce)
+				actions = append(actions, &action{kind: actionCancel, nonce: nonce})
+			}
+			continue
+		}
+
+		if now.Sub(tracked.sentAt) > m.stuckTimeout {
+			actions = append(actions, &action{kind: actionReplace, nonce: nonce, tx: tracked.tx})
+			continue
+		}
+
+		if mempool[nonce] == nil {
+			actions = append(actions, &action{kind: actionRebroadcast, nonce: nonce, tx: tracked.tx})
+		}
+	}
+
+	return actions
+}
+
+// execute takes the given action on an in-flight nonce.
+func (m *NonceManager) execute(ctx context.Context, a *action) error {
+	log.Info("Fix in-flight proposer nonce", "action", a.kind, "nonce", a.nonce)
+
+	switch a.kind {
+	case actionRebroadcast:
+		if err := m.rpc.L1.SendTransaction(ctx, a.tx); err != nil && !errors.Is(err, txpool.ErrAlreadyKnown) {
+			return err
+		}
+		return nil
+	case actionReplace:
+		tx, err := m.replacement(ctx, a.tx)
+		if err != nil {
+			if errors.Is(err, txpool.ErrReplaceUnderpriced) {
+				// Max gas tip cap reached, keep waiting.
+				return nil
+			}
+			return err
+		}
+		return m.send(ctx, tx)
+	default:
+		tx, err := m.cancellation(ctx, a.nonce)
+		if err != nil {
+			return err
+		}
+		if err := m.send(ctx, tx); err != nil {
+			return err
+		}
+		delete(m.holes, a.nonce)
+		return nil
+	}
+}
+
+// send sends the given transaction, and tracks it as in-flight.
+func (m *NonceManager) send(ctx context.Context, tx *types.Transaction) error {
+	if err := m.rpc.L1.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	m.inFlight[tx.Nonce()] = &inFlightTx{tx: tx, sentAt: time.Now()}
+
+	return nil
+}
+
+// replacement creates a new transaction replacing the given stuck one, with the same payload and a bumped
+// gas tip cap.
+func (m *NonceManager) replacement(ctx context.Context, stuck *types.Transaction) (*types.Transaction, error) {
+	opts := signer.NewTransactOpts(ctx, m.signer, m.rpc.L1ChainID)
+	opts.Nonce = new(big.Int).SetUint64(stuck.Nonce())
+	opts.GasTipCap = stuck.GasTipCap()
+
+	opts, err := rpc.IncreaseGasTipCap(ctx, m.rpc, opts, m.address, m.tipMultiplier, m.maxGasTipCap)
+	if err != nil {
+		return nil, err
+	}
+
+	gasFeeCap, err := m.gasFeeCap(ctx, opts.GasTipCap)
+	if err != nil {
+		return nil, err
+	}
+	if bumped := new(big.Int).Mul(stuck.GasFeeCap(), m.tipMultiplier); bumped.Cmp(gasFeeCap) > 0 {
+		gasFeeCap = bumped
+	}
+
+	var txData types.TxData
+	if stuck.Type() == types.BlobTxType {
+		txData = &types.BlobTx{
+			ChainID:    uint256.MustFromBig(m.rpc.L1ChainID),
+			Nonce:      stuck.Nonce(),
+			GasTipCap:  uint256.MustFromBig(opts.GasTipCap),
+			GasFeeCap:  uint256.MustFromBig(gasFeeCap),
+			Gas:        stuck.Gas(),
+			To:         *stuck.To(),
+			Value:      uint256.MustFromBig(stuck.Value()),
+			Data:       stuck.Data(),
+			BlobFeeCap: uint256.MustFromBig(new(big.Int).Mul(stuck.BlobGasFeeCap(), m.tipMultiplier)),
+			BlobHashes: stuck.BlobHashes(),
+			Sidecar:    stuck.BlobTxSidecar(),
+		}
+	} else {
+		txData = &types.DynamicFeeTx{
+			ChainID:   m.rpc.L1ChainID,
+			Nonce:     stuck.Nonce(),
+			GasTipCap: opts.GasTipCap,
+			GasFeeCap: gasFeeCap,
+			Gas:       stuck.Gas(),
+			To:        stuck.To(),
+			Value:     stuck.Value(),
+			Data:      stuck.Data(),
+		}
+	}
+
+	return m.signer.SignTx(ctx, types.NewTx(txData), m.rpc.L1ChainID)
+}
+
+// cancellation creates a zero value self transfer transaction with the given nonce.
+func (m *NonceManager) cancellation(ctx context.Context, nonce uint64) (*types.Transaction, error) {
+	gasTipCap, err := m.rpc.L1.SuggestGasTipCap(ctx)
+	if err != nil {
+		if !rpc.IsMaxPriorityFeePerGasNotFoundError(err) {
+			return nil, err
+		}
+		gasTipCap = rpc.FallbackGasTipCap
+	}
+	gasTipCap = new(big.Int).Mul(gasTipCap, m.tipMultiplier)
+	if m.maxGasTipCap != nil && gasTipCap.Cmp(m.maxGasTipCap) > 0 {
+		gasTipCap = m.maxGasTipCap
+	}
+
+	gasFeeCap, err := m.gasFeeCap(ctx, gasTipCap)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.signer.SignTx(ctx, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   m.rpc.L1ChainID,
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       params.TxGas,
+		To:        &m.address,
+		Value:     common.Big0,
+	}), m.rpc.L1ChainID)
+}
+
+// gasFeeCap returns a gas fee cap for the given gas tip cap, based on the latest L1 base fee.
+func (m *NonceManager) gasFeeCap(ctx context.Context, gasTipCap *big.Int) (*big.Int, error) {
+	head, err := m.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).Add(gasTipCap, new(big.Int).Mul(head.BaseFee, common.Big2)), nil
+}
+
+// mempoolTxsByNonce indexes the given account's mempool content by nonces.
+func mempoolTxsByNonce(content rpc.AccountPoolContent) (map[uint64]*types.Transaction, error) {
+	txs := make(map[uint64]*types.Transaction)
+	for _, txMap := range content {
+		for nonceStr, tx := range txMap {
+			nonce, err := strconv.ParseUint(nonceStr, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid nonce in mempool content: %w", err)
+			}
+			txs[nonce] = tx
+		}
+	}
+
+	return txs, nil
+}
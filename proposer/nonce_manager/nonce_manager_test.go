@@ -0,0 +1,141 @@
+package noncemanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+)
+
+func newTestNonceManager(t *testing.T) *NonceManager {
+	privKey, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	return New(nil, signer.NewLocalSigner(privKey), time.Minute, 2, nil)
+}
+
+func newTestTx(nonce uint64) *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		Nonce:     nonce,
+		GasTipCap: common.Big1,
+		GasFeeCap: common.Big2,
+		Gas:       21000,
+		Value:     common.Big0,
+	})
+}
+
+func TestNextSentRelease(t *testing.T) {
+	m := newTestNonceManager(t)
+	m.next = 5
+
+	require.Equal(t, uint64(5), m.Next())
+	require.Equal(t, uint64(6), m.Next())
+	require.Equal(t, uint64(7), m.next)
+
+	m.Sent(newTestTx(5))
+	m.Release(5)
+	require.NotContains(t, m.holes, uint64(5))
+
+	m.Release(6)
+	require.Contains(t, m.holes, uint64(6))
+
+	// A transaction sent with a higher nonce moves the next nonce forward.
+	m.Sent(newTestTx(10))
+	require.Equal(t, uint64(11), m.next)
+}
+
+func TestCheck(t *testing.T) {
+	m := newTestNonceManager(t)
+	now := time.Now()
+
+	m.next = 7
+	m.inFlight[1] = &inFlightTx{tx: newTestTx(1), sentAt: now}
+	m.inFlight[2] = &inFlightTx{tx: newTestTx(2), sentAt: now}
+	m.inFlight[3] = &inFlightTx{tx: newTestTx(3), sentAt: now.Add(-2 * time.Minute)}
+	m.inFlight[4] = &inFlightTx{tx: newTestTx(4), sentAt: now}
+	m.holes[0] = struct{}{}
+	m.holes[5] = struct{}{}
+
+	mempool := map[uint64]*types.Transaction{
+		2: newTestTx(2),
+		3: newTestTx(3),
+		6: newTestTx(6),
+	}
+
+	actions := m.check(2, mempool, now)
+
+	// Confirmed nonces are pruned.
+	require.NotContains(t, m.inFlight, uint64(1))
+	require.NotContains(t, m.holes, uint64(0))
+
+	// The untracked transaction in mempool is adopted.
+	require.Contains(t, m.inFlight, uint64(6))
+
+	require.Len(t, actions, 3)
+	require.Equal(t, actionReplace, actions[0].kind)
+	require.Equal(t, uint64(3), actions[0].nonce)
+	require.Equal(t, actionRebroadcast, actions[1].kind)
+	require.Equal(t, uint64(4), actions[1].nonce)
+	require.Equal(t, actionCancel, actions[2].kind)
+	require.Equal(t, uint64(5), actions[2].nonce)
+}
+
+func TestCheckBlobTxs(t *testing.T) {
+	m := newTestNonceManager(t)
+	now := time.Now()
+
+	newBlobTx := func(nonce uint64) *types.Transaction {
+		return types.NewTx(&types.BlobTx{Nonce: nonce, Gas: 21000, BlobHashes: []common.Hash{{1}}})
+	}
+
+	m.next = 3
+	m.inFlight[0] = &inFlightTx{tx: newBlobTx(0), sentAt: now.Add(-2 * time.Minute)}
+	m.inFlight[1] = &inFlightTx{tx: newBlobTx(1), sentAt: now}
+	m.holes[2] = struct{}{}
+
+	mempool := map[uint64]*types.Transaction{
+		0: newBlobTx(0),
+		2: newBlobTx(2),
+	}
+
+	actions := m.check(0, mempool, now)
+
+	// The released nonce with a pending transaction in mempool is adopted instead of cancelled.
+	require.Contains(t, m.inFlight, uint64(2))
+	require.NotContains(t, m.holes, uint64(2))
+
+	// The stuck sidecar-less blob transaction is not replaced, and the dropped one is cancelled.
+	require.Len(t, actions, 1)
+	require.Equal(t, actionCancel, actions[0].kind)
+	require.Equal(t, uint64(1), actions[0].nonce)
+	require.NotContains(t, m.inFlight, uint64(1))
+}
+
+func TestCheckNextBehindConfirmed(t *testing.T) {
+	m := newTestNonceManager(t)
+	m.next = 1
+
+	require.Empty(t, m.check(3, map[uint64]*types.Transaction{}, time.Now()))
+	require.Equal(t, uint64(3), m.next)
+}
+
+func TestMempoolTxsByNonce(t *testing.T) {
+	txs, err := mempoolTxsByNonce(map[string]map[string]*types.Transaction{
+		"pending": {"1": newTestTx(1)},
+		"queued":  {"3": newTestTx(3)},
+	})
+	require.Nil(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, uint64(1), txs[1].Nonce())
+	require.Equal(t, uint64(3), txs[3].Nonce())
+	require.Nil(t, txs[2])
+
+	_, err = mempoolTxsByNonce(map[string]map[string]*types.Transaction{
+		"pending": {"invalid": newTestTx(1)},
+	})
+	require.NotNil(t, err)
+}
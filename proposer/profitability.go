@@ -0,0 +1,254 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"golang.org/x/sync/errgroup"
+)
+
+// proposeProfitableTxLists proposes the given encoded transactions lists in order, only if the estimated L2 fee
+// revenue of a transactions list covers its estimated proposing cost. An unprofitable transactions list will be
+// merged into the next one if possible, otherwise it will be deferred to the next proposing epoch along with all
+// the following ones, to keep the transactions order. The prover fee is estimated through the configured tier
+// fees at first, so that a prover assignment is only requested for the profitable transactions lists, then the
+// profitability is checked again with the prover fee actually agreed, which may have been bumped.
+// Returns all transactions which have been proposed.
+func (p *Proposer) proposeProfitableTxLists(
+	ctx context.Context,
+	txLists []*encodedTxList,
+	l2BaseFee *big.Int,
+) (types.Transactions, error) {
+	var (
+		g           = new(errgroup.Group)
+		proposedTxs types.Transactions
+		err         error
+	)
+	for i := 0; i < len(txLists); i++ {
+		txList := txLists[i]
+
+		var blob *blobTxList
+		if p.cfg.BlobAllowed {
+			if blob, err = newBlobTxList(txList.bytes, p.cfg.CacheBlobForReuse); err != nil {
+				break
+			}
+		}
+
+		var (
+			profitable    bool
+			assignment    *encoding.ProverAssignment
+			proverAddress common.Address
+			maxFee        *big.Int
+		)
+		if profitable, err = p.isProfitable(ctx, txList, blob, l2BaseFee, maxTierFee(p.tierFees)); err != nil {
+			break
+		}
+		if profitable {
+			if assignment, proverAddress, maxFee, err = p.assignProver(ctx, txList.bytes, blob); err != nil {
+				break
+			}
+			var proverFee *big.Int
+			if proverFee, err = p.proverFeeInWei(ctx, maxFee); err != nil {
+				break
+			}
+			if profitable, err = p.isProfitable(ctx, txList, blob, l2BaseFee, proverFee); err != nil {
+				break
+			}
+		}
+
+		if !profitable {
+			if i+1 < len(txLists) {
+				var merged *encodedTxList
+				if merged, err = p.mergeTxLists(txList, txLists[i+1]); err != nil {
+					break
+				}
+				if merged != nil {
+					txLists[i+1] = merged
+					continue
+				}
+			}
+
+			log.Info("Defer unprofitable transactions lists to the next epoch", "count", len(txLists)-i)
+			metrics.ProposerUnprofitableTxListsCounter.Inc(int64(len(txLists) - i))
+			break
+		}
+
+		txNonce := p.nonceManager.Next()
+		g.Go(func() error {
+			if err := p.sendTxList(
+				ctx,
+				txList.bytes,
+				uint(txList.txs.Len()),
+				&txNonce,
+				blob,
+				assignment,
+				proverAddress,
+				maxFee,
+			); err != nil {
+				return fmt.Errorf("failed to propose transactions: %w", err)
+			}
+
+			return nil
+		})
+		proposedTxs = append(proposedTxs, txList.txs...)
+	}
+
+	// Always wait for the transactions lists which have already been sent.
+	if waitErr := g.Wait(); waitErr != nil {
+		return nil, waitErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return proposedTxs, nil
+}
+
+// isProfitable checks whether the estimated L2 fee revenue of the given transactions list covers its estimated
+// proposing cost, with the given prover fee.
+func (p *Proposer) isProfitable(
+	ctx context.Context,
+	txList *encodedTxList,
+	blob *blobTxList,
+	l2BaseFee *big.Int,
+	proverFee *big.Int,
+) (bool, error) {
+	tip, err := p.proposerTip(ctx, txList.bytes)
+	if err != nil {
+		return false, err
+	}
+	cost, err := p.estimateProposingCost(ctx, txList.bytes, blob, new(big.Int).Add(proverFee, tip))
+	if err != nil {
+		return false, err
+	}
+
+	revenue := estimateRevenue(txList.txs, l2BaseFee, p.cfg.L2BaseFeeSharePercentage)
+	if revenue.Cmp(cost) < 0 {
+		log.Info(
+			"Unprofitable transactions list",
+			"txs", txList.txs.Len(),
+			"revenue", revenue,
+			"cost", cost,
+			"proverFee", proverFee,
+			"tip", tip,
+		)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// maxTierFee returns the highest fee of the given tier fees.
+func maxTierFee(tierFees []encoding.TierFee) *big.Int {
+	maxFee := new(big.Int)
+	for _, tierFee := range tierFees {
+		if tierFee.Fee.Cmp(maxFee) > 0 {
+			maxFee = tierFee.Fee
+		}
+	}
+
+	return maxFee
+}
+
+// estimateRevenue estimates the L2 fee revenue of the given transactions at the given L2 base fee, the
+// priority fee and the proposer's share of the base fee are counted. Transactions gas limits are used as the
+// gas used estimation.
+func estimateRevenue(txs types.Transactions, baseFee *big.Int, baseFeeSharePercentage uint64) *big.Int {
+	var (
+		revenue      = new(big.Int)
+		baseFeeShare = new(big.Int).Div(
+			new(big.Int).Mul(baseFee, new(big.Int).SetUint64(baseFeeSharePercentage)),
+			big.NewInt(100),
+		)
+	)
+	for _, tx := range txs {
+		tip := tx.EffectiveGasTipValue(baseFee)
+		if tip.Sign() < 0 {
+			continue
+		}
+
+		revenue.Add(revenue, new(big.Int).Mul(
+			new(big.Int).SetUint64(tx.Gas()),
+			new(big.Int).Add(tip, baseFeeShare),
+		))
+	}
+
+	return revenue
+}
+
+// estimateProposingCost estimates the L1 cost of proposing the given transactions list, including the
+// TaikoL1.proposeBlock transaction fee, the blob fee if a new blob is carried, and the fees paid through the
+// AssignmentHook, i.e. the prover fee plus the tip.
+func (p *Proposer) estimateProposingCost(
+	ctx context.Context,
+	txListBytes []byte,
+	blob *blobTxList,
+	hookFees *big.Int,
+) (*big.Int, error) {
+	head, err := p.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	gasTipCap, err := p.rpc.L1.SuggestGasTipCap(ctx)
+	if err != nil {
+		if !rpc.IsMaxPriorityFeePerGasNotFoundError(err) {
+			return nil, err
+		}
+		gasTipCap = rpc.FallbackGasTipCap
+	}
+
+	gas := p.cfg.ProposeBlockGasEstimate
+	if p.proposeBlockTxGasLimit != nil {
+		gas = *p.proposeBlockTxGasLimit
+	}
+	if blob == nil {
+		gas += uint64(len(txListBytes)) * params.TxDataNonZeroGasEIP2028
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(gas), new(big.Int).Add(head.BaseFee, gasTipCap))
+	if blob != nil && blob.sidecar != nil && head.ExcessBlobGas != nil {
+		cost.Add(cost, new(big.Int).Mul(
+			eip4844.CalcBlobFee(*head.ExcessBlobGas),
+			new(big.Int).SetUint64(params.BlobTxBlobGasPerBlob),
+		))
+	}
+
+	return cost.Add(cost, hookFees), nil
+}
+
+// mergeTxLists merges the two given encoded transactions lists into one, returns nil if the merged
+// transactions list exceeds the protocol's limits.
+func (p *Proposer) mergeTxLists(a, b *encodedTxList) (*encodedTxList, error) {
+	var (
+		txs      = append(append(types.Transactions{}, a.txs...), b.txs...)
+		gasLimit uint64
+	)
+	for _, tx := range txs {
+		gasLimit += tx.Gas()
+	}
+	if gasLimit > uint64(p.protocolConfigs.BlockMaxGasLimit) {
+		return nil, nil
+	}
+
+	merged, err := p.encodeTxList(txs)
+	if err != nil {
+		return nil, err
+	}
+	if len(merged) != 1 {
+		return nil, nil
+	}
+
+	log.Info("Merged transactions lists", "txs", merged[0].txs.Len(), "size", len(merged[0].bytes))
+
+	return merged[0], nil
+}
@@ -0,0 +1,75 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/txlistcodec"
+)
+
+func TestEstimateRevenue(t *testing.T) {
+	baseFee := big.NewInt(100)
+	txs := types.Transactions{
+		// Tip: min(10, 200 - 100) = 10
+		types.NewTx(&types.DynamicFeeTx{Gas: 21000, GasTipCap: big.NewInt(10), GasFeeCap: big.NewInt(200)}),
+		// Tip: min(50, 120 - 100) = 20
+		types.NewTx(&types.DynamicFeeTx{Gas: 50000, GasTipCap: big.NewInt(50), GasFeeCap: big.NewInt(120)}),
+		// Fee cap lower than base fee, not counted.
+		types.NewTx(&types.DynamicFeeTx{Gas: 50000, GasTipCap: big.NewInt(50), GasFeeCap: big.NewInt(50)}),
+	}
+
+	require.Equal(t, big.NewInt(21000*10+50000*20), estimateRevenue(txs, baseFee, 0))
+	require.Equal(t, big.NewInt(21000*(10+50)+50000*(20+50)), estimateRevenue(txs, baseFee, 50))
+	require.Equal(t, common.Big0.Int64(), estimateRevenue(types.Transactions{}, baseFee, 100).Int64())
+}
+
+func TestMaxTierFee(t *testing.T) {
+	require.Equal(t, common.Big0.Int64(), maxTierFee(nil).Int64())
+	require.Equal(t, big.NewInt(300), maxTierFee([]encoding.TierFee{
+		{Tier: 100, Fee: big.NewInt(100)},
+		{Tier: 200, Fee: big.NewInt(300)},
+		{Tier: 300, Fee: big.NewInt(200)},
+	}))
+}
+
+func TestMergeTxLists(t *testing.T) {
+	codec, err := txlistcodec.New(txlistcodec.None)
+	require.Nil(t, err)
+
+	p := &Proposer{
+		cfg:         &Config{},
+		txListCodec: codec,
+		protocolConfigs: &bindings.TaikoDataConfig{
+			BlockMaxGasLimit:    21000 * 4,
+			BlockMaxTxListBytes: big.NewInt(4096),
+		},
+	}
+
+	a, err := p.encodeTxList(newTestTxList(2, 0))
+	require.Nil(t, err)
+	b, err := p.encodeTxList(newTestTxList(2, 0))
+	require.Nil(t, err)
+
+	merged, err := p.mergeTxLists(a[0], b[0])
+	require.Nil(t, err)
+	require.NotNil(t, merged)
+	require.Equal(t, 4, merged.txs.Len())
+
+	// Exceeds the block gas limit.
+	merged, err = p.mergeTxLists(merged, b[0])
+	require.Nil(t, err)
+	require.Nil(t, merged)
+
+	// Exceeds the transactions list size limit.
+	c, err := p.encodeTxList(newTestTxList(1, 4070))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(c))
+	merged, err = p.mergeTxLists(a[0], c[0])
+	require.Nil(t, err)
+	require.Nil(t, merged)
+}
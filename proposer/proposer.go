@@ -3,7 +3,6 @@ package proposer
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
@@ -25,17 +24,27 @@ import (
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/metrics"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+	"github.com/taikoxyz/taiko-client/pkg/txlistcodec"
+	forcedinclusion "github.com/taikoxyz/taiko-client/proposer/forced_inclusion"
+	election "github.com/taikoxyz/taiko-client/proposer/leader_election"
+	noncemanager "github.com/taikoxyz/taiko-client/proposer/nonce_manager"
 	selector "github.com/taikoxyz/taiko-client/proposer/prover_selector"
+	policy "github.com/taikoxyz/taiko-client/proposer/tx_list_policy"
 	"github.com/urfave/cli/v2"
 	"golang.org/x/sync/errgroup"
 )
 
 var (
 	errNoNewTxs                = errors.New("no new transactions")
+	errLeadershipLost          = errors.New("proposer leadership lost")
 	maxSendProposeBlockTxRetry = 10
 	retryInterval              = 12 * time.Second
 	proverAssignmentTimeout    = 30 * time.Minute
 	requestProverServerTimeout = 12 * time.Second
+	// When the transactions lists are compressed, more bytes are fetched from the transaction pool,
+	// oversized lists will be split after compression.
+	estimatedCompressionRatio = uint64(3)
 )
 
 // Proposer keep proposing new transactions from L2 execution engine's tx pool at a fixed interval.
@@ -44,24 +53,41 @@ type Proposer struct {
 	rpc *rpc.Client
 
 	// Private keys and account addresses
-	proposerPrivKey *ecdsa.PrivateKey
+	proposerSigner  signer.Signer
 	proposerAddress common.Address
 
 	// Proposing configurations
 	proposingInterval          *time.Duration
 	proposeEmptyBlocksInterval *time.Duration
 	proposingTimer             *time.Timer
+	adaptiveInterval           *adaptiveInterval
 	locals                     []common.Address
 	localsOnly                 bool
 	maxProposedTxListsPerEpoch uint64
 	proposeBlockTxGasLimit     *uint64
 	txReplacementTipMultiplier uint64
 	proposeBlockTxGasTipCap    *big.Int
+	txListCodec                txlistcodec.Codec
+	txListPolicies             []policy.TxListPolicy
 	tiers                      []*rpc.TierProviderTierWithID
 	tierFees                   []encoding.TierFee
 
 	// Prover selector
-	proverSelector selector.ProverSelector
+	proverSelector   selector.ProverSelector
+	proverReputation *selector.Reputation
+	proverDiscovery  *selector.Discovery
+
+	// Nonce manager
+	nonceManager *noncemanager.NonceManager
+
+	// Forced inclusion inbox
+	forcedInclusion *forcedinclusion.Inbox
+
+	// Leader election, nil means always proposing
+	elector *election.Elector
+
+	// Dry-run mode, the proposeBlock transactions are recorded instead of being sent
+	dryRun *dryRunRecorder
 
 	// Protocol configurations
 	protocolConfigs *bindings.TaikoDataConfig
@@ -90,8 +116,11 @@ func (p *Proposer) InitFromCli(ctx context.Context, c *cli.Context) error {
 
 // InitFromConfig initializes the proposer instance based on the given configurations.
 func InitFromConfig(ctx context.Context, p *Proposer, cfg *Config) (err error) {
-	p.proposerPrivKey = cfg.L1ProposerPrivKey
-	p.proposerAddress = crypto.PubkeyToAddress(cfg.L1ProposerPrivKey.PublicKey)
+	p.proposerSigner = cfg.L1ProposerSigner
+	if p.proposerSigner == nil {
+		p.proposerSigner = signer.NewLocalSigner(cfg.L1ProposerPrivKey)
+	}
+	p.proposerAddress = p.proposerSigner.Address()
 	p.proposingInterval = cfg.ProposeInterval
 	p.proposeEmptyBlocksInterval = cfg.ProposeEmptyBlocksInterval
 	p.proposeBlockTxGasLimit = cfg.ProposeBlockTxGasLimit
@@ -105,6 +134,25 @@ func InitFromConfig(ctx context.Context, p *Proposer, cfg *Config) (err error) {
 	p.waitReceiptTimeout = cfg.WaitReceiptTimeout
 	p.cfg = cfg
 
+	if p.txListCodec, err = txlistcodec.New(cfg.TxListCodec); err != nil {
+		return err
+	}
+
+	p.txListPolicies = newTxListPolicies(cfg)
+
+	if cfg.AdaptiveProposeInterval {
+		initial := cfg.MinProposeInterval
+		if cfg.ProposeInterval != nil {
+			initial = *cfg.ProposeInterval
+		}
+		p.adaptiveInterval = newAdaptiveInterval(
+			initial,
+			cfg.MinProposeInterval,
+			cfg.MaxProposeInterval,
+			cfg.L1BaseFeeSpikePercentage,
+		)
+	}
+
 	// RPC clients
 	if p.rpc, err = rpc.NewClient(p.ctx, &rpc.ClientConfig{
 		L1Endpoint:        cfg.L1Endpoint,
@@ -127,6 +175,10 @@ func InitFromConfig(ctx context.Context, p *Proposer, cfg *Config) (err error) {
 
 	log.Info("Protocol configs", "configs", p.protocolConfigs)
 
+	if cfg.BlobAllowed && !protocolConfigs.BlobAllowedForDA {
+		return errors.New("blob is not allowed for data availability in protocol configs")
+	}
+
 	if p.tiers, err = p.rpc.GetTiers(ctx); err != nil {
 		return err
 	}
@@ -134,7 +186,33 @@ func InitFromConfig(ctx context.Context, p *Proposer, cfg *Config) (err error) {
 		return err
 	}
 
-	if p.proverSelector, err = selector.NewETHFeeEOASelector(
+	if p.proverReputation, err = selector.NewReputation(&selector.ReputationConfig{
+		StorePath:         cfg.ProverReputationFile,
+		CooldownThreshold: cfg.ProverCooldownThreshold,
+		CooldownDuration:  cfg.ProverCooldown,
+		BlacklistScore:    cfg.ProverBlacklistScore,
+		BlacklistDuration: cfg.ProverBlacklistDuration,
+	}); err != nil {
+		return err
+	}
+
+	if cfg.ProverRegistryURL != "" || cfg.ProverRegistryFile != "" {
+		if p.proverDiscovery, err = selector.NewDiscovery(&selector.DiscoveryConfig{
+			RegistryURL:    cfg.ProverRegistryURL,
+			FilePath:       cfg.ProverRegistryFile,
+			Interval:       cfg.ProverRegistryInterval,
+			RequestTimeout: requestProverServerTimeout,
+		}); err != nil {
+			return err
+		}
+	}
+
+	var auctionDeadline time.Duration
+	if cfg.ProverAuction {
+		auctionDeadline = cfg.ProverAuctionDeadline
+	}
+
+	ethFeeSelector, err := selector.NewETHFeeEOASelector(
 		&protocolConfigs,
 		p.rpc,
 		cfg.TaikoL1Address,
@@ -142,20 +220,99 @@ func InitFromConfig(ctx context.Context, p *Proposer, cfg *Config) (err error) {
 		p.tierFees,
 		cfg.TierFeePriceBump,
 		cfg.ProverEndpoints,
+		p.proverReputation,
+		p.proverDiscovery,
 		cfg.MaxTierFeePriceBumps,
 		proverAssignmentTimeout,
 		requestProverServerTimeout,
-	); err != nil {
+		auctionDeadline,
+	)
+	if err != nil {
 		return err
 	}
+	if p.proverSelector = ethFeeSelector; cfg.FeeToken != rpc.ZeroAddress {
+		if p.proverSelector, err = selector.NewERC20FeeEOASelector(
+			ethFeeSelector,
+			cfg.FeeToken,
+			cfg.FeeTokenPriceFeed,
+		); err != nil {
+			return err
+		}
+	}
+
+	if cfg.ForcedInclusionURL != "" || cfg.ForcedInclusionFile != "" {
+		if p.forcedInclusion, err = forcedinclusion.NewInbox(&forcedinclusion.InboxConfig{
+			URL:              cfg.ForcedInclusionURL,
+			FilePath:         cfg.ForcedInclusionFile,
+			RequestTimeout:   requestProverServerTimeout,
+			ResubmitTimeout:  cfg.ForcedInclusionResubmitTimeout,
+			MaxResubmissions: cfg.ForcedInclusionMaxResubmissions,
+			Retention:        cfg.ForcedInclusionRetention,
+		}, p.rpc.L2ChainID); err != nil {
+			return err
+		}
+	}
+
+	if cfg.LeaderElectionFile != "" {
+		backend, err := election.NewFileBackend(cfg.LeaderElectionFile)
+		if err != nil {
+			return err
+		}
+		if p.elector, err = election.New(&election.Config{
+			ID:       cfg.LeaderElectionID,
+			LeaseTTL: cfg.LeaderLeaseTTL,
+			Backend:  backend,
+		}, p.rpc.L1, p.proposerAddress); err != nil {
+			return err
+		}
+	}
+
+	if cfg.DryRun {
+		if p.dryRun, err = newDryRunRecorder(cfg.DryRunFile); err != nil {
+			return fmt.Errorf("failed to open dry-run file: %w", err)
+		}
+		log.Warn("Proposer running in dry-run mode, no proposeBlock transaction will be sent", "file", cfg.DryRunFile)
+	}
+
+	p.nonceManager = noncemanager.New(
+		p.rpc,
+		p.proposerSigner,
+		cfg.StuckTxTimeout,
+		cfg.ProposeBlockTxReplacementMultiplier,
+		cfg.ProposeBlockTxGasTipCap,
+	)
 
 	return nil
 }
 
 // Start starts the proposer's main loop.
 func (p *Proposer) Start() error {
-	p.wg.Add(1)
+	if p.dryRun == nil {
+		if err := p.setFeeTokenAllowance(p.ctx); err != nil {
+			return fmt.Errorf("failed to set fee token allowance: %w", err)
+		}
+	}
+
+	p.wg.Add(2)
 	go p.eventLoop()
+	go func() {
+		defer p.wg.Done()
+		p.proverReputation.Track(p.ctx, p.rpc)
+	}()
+	if p.proverDiscovery != nil {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.proverDiscovery.Start(p.ctx)
+		}()
+	}
+	if p.elector != nil {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.elector.Start(p.ctx)
+		}()
+	}
 	return nil
 }
 
@@ -175,6 +332,13 @@ func (p *Proposer) eventLoop() {
 			return
 		// proposing interval timer has been reached
 		case <-p.proposingTimer.C:
+			// Only the leader proposes, when there are redundant proposer instances.
+			if p.elector != nil && !p.elector.IsLeader() {
+				log.Debug("Not the proposer leader, skip proposing")
+				lastNonEmptyBlockProposedAt = time.Now()
+				continue
+			}
+
 			metrics.ProposerProposeEpochCounter.Inc(1)
 			// attempt propose operation
 			if err := p.ProposeOp(p.ctx); err != nil {
@@ -206,6 +370,11 @@ func (p *Proposer) eventLoop() {
 // Close closes the proposer instance.
 func (p *Proposer) Close(ctx context.Context) {
 	p.wg.Wait()
+	if p.dryRun != nil {
+		if err := p.dryRun.Close(); err != nil {
+			log.Error("Failed to close dry-run file", "error", err)
+		}
+	}
 }
 
 // ProposeOp performs a proposing operation, fetching transactions
@@ -239,12 +408,17 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 
 	log.Info("Current base fee", "fee", baseFee)
 
+	maxBytesPerTxList := p.protocolConfigs.BlockMaxTxListBytes.Uint64()
+	if p.txListCodec.Name() != txlistcodec.None {
+		maxBytesPerTxList *= estimatedCompressionRatio
+	}
+
 	txLists, err := p.rpc.GetPoolContent(
 		ctx,
 		p.proposerAddress,
 		baseFee,
 		p.protocolConfigs.BlockMaxGasLimit,
-		p.protocolConfigs.BlockMaxTxListBytes.Uint64(),
+		maxBytesPerTxList,
 		p.locals,
 		p.maxProposedTxListsPerEpoch,
 	)
@@ -279,54 +453,91 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		txLists = localTxsLists
 	}
 
+	if txLists, err = policy.Apply(p.txListPolicies, txLists, &policy.Env{
+		Signer:  types.LatestSignerForChainID(p.rpc.L2ChainID),
+		BaseFee: baseFee,
+	}); err != nil {
+		return fmt.Errorf("failed to apply transactions list policies: %w", err)
+	}
+
+	// The forced transactions are proposed in a separate transactions list before the pool content.
+	var forcedTxs types.Transactions
+	if p.forcedInclusion != nil {
+		if err := p.forcedInclusion.Refresh(ctx, p.rpc.L2); err != nil {
+			log.Warn("Failed to refresh forced inclusion inbox", "error", err)
+		}
+		forcedTxs = p.forcedInclusion.Pending(uint64(p.protocolConfigs.BlockMaxGasLimit), baseFee)
+		if forcedTxs.Len() != 0 {
+			log.Info("Proposing forced transactions", "count", forcedTxs.Len())
+			txLists = prependForcedTxs(forcedTxs, txLists)
+		}
+	}
+
 	log.Info("Transactions lists count", "count", len(txLists))
 
+	if p.adaptiveInterval != nil {
+		l1Head, err := p.rpc.L1.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return err
+		}
+		p.adaptiveInterval.Observe(&poolObservation{
+			txLists:     txLists,
+			maxBytes:    maxBytesPerTxList,
+			maxGasLimit: uint64(p.protocolConfigs.BlockMaxGasLimit),
+			l1BaseFee:   l1Head.BaseFee,
+		})
+	}
+
+	// In dry-run mode, nothing is sent with the reserved nonces, so there is no need to track them. Otherwise
+	// the nonces are synced even if there is nothing to propose, so that a wedged account can recover.
+	if p.dryRun == nil {
+		if err := p.nonceManager.Sync(ctx); err != nil {
+			return fmt.Errorf("failed to sync proposer nonces: %w", err)
+		}
+	}
+
 	if len(txLists) == 0 {
 		return errNoNewTxs
 	}
 
-	head, err := p.rpc.L1.BlockNumber(ctx)
+	encodedTxLists, err := p.encodeTxLists(txLists)
 	if err != nil {
 		return err
 	}
-	nonce, err := p.rpc.L1.NonceAt(
-		ctx,
-		crypto.PubkeyToAddress(p.proposerPrivKey.PublicKey),
-		new(big.Int).SetUint64(head),
-	)
-	if err != nil {
-		return err
+	if len(encodedTxLists) > int(p.maxProposedTxListsPerEpoch) {
+		encodedTxLists = encodedTxLists[:p.maxProposedTxListsPerEpoch]
 	}
 
-	log.Info("Proposer account information", "chainHead", head, "nonce", nonce)
-
-	g := new(errgroup.Group)
-	for i, txs := range txLists {
-		func(i int, txs types.Transactions) {
-			g.Go(func() error {
-				if i >= int(p.maxProposedTxListsPerEpoch) {
-					return nil
-				}
-
-				txListBytes, err := rlp.EncodeToBytes(txs)
-				if err != nil {
-					return fmt.Errorf("failed to encode transactions: %w", err)
-				}
-
-				txNonce := nonce + uint64(i)
-				if err := p.ProposeTxList(ctx, txListBytes, uint(txs.Len()), &txNonce); err != nil {
-					return fmt.Errorf("failed to propose transactions: %w", err)
-				}
+	// All proposals would revert if the AssignmentHook contract can't transfer the prover fees.
+	if p.dryRun == nil {
+		if err := p.ensureFeeTokenAllowance(ctx, len(encodedTxLists)); err != nil {
+			return fmt.Errorf("failed to ensure fee token allowance: %w", err)
+		}
+	}
 
-				return nil
-			})
-		}(i, txs)
+	// The unprofitable transactions lists may be deferred, so only the transactions which have actually
+	// been proposed are counted.
+	var proposedTxs types.Transactions
+	for _, txList := range encodedTxLists {
+		proposedTxs = append(proposedTxs, txList.txs...)
 	}
 
-	if err := g.Wait(); err != nil {
+	switch {
+	case p.cfg.PackTxListsIntoBlob:
+		err = p.proposeTxListsInSharedBlobs(ctx, encodedTxLists)
+	case p.cfg.ProfitabilityCheck:
+		proposedTxs, err = p.proposeProfitableTxLists(ctx, encodedTxLists, baseFee)
+	default:
+		err = p.proposeTxLists(ctx, encodedTxLists)
+	}
+	if err != nil {
 		return fmt.Errorf("failed to propose transactions: %w", err)
 	}
 
+	if forcedTxs.Len() != 0 && p.dryRun == nil {
+		p.forcedInclusion.MarkProposed(proposedTxs)
+	}
+
 	if p.AfterCommitHook != nil {
 		if err := p.AfterCommitHook(); err != nil {
 			log.Error("Run AfterCommitHook error", "error", err)
@@ -336,24 +547,51 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 	return nil
 }
 
+// proposeTxLists proposes the given encoded transactions lists in parallel, with nonces handed out by
+// the nonce manager.
+func (p *Proposer) proposeTxLists(ctx context.Context, txLists []*encodedTxList) error {
+	g := new(errgroup.Group)
+	for _, txList := range txLists {
+		func(txList *encodedTxList, txNonce uint64) {
+			g.Go(func() error {
+				if err := p.proposeEncodedTxList(ctx, txList.bytes, uint(txList.txs.Len()), &txNonce); err != nil {
+					return fmt.Errorf("failed to propose transactions: %w", err)
+				}
+
+				return nil
+			})
+		}(txList, p.nonceManager.Next())
+	}
+
+	return g.Wait()
+}
+
 // sendProposeBlockTx tries to send a TaikoL1.proposeBlock transaction.
 func (p *Proposer) sendProposeBlockTx(
 	ctx context.Context,
 	txListBytes []byte,
+	blob *blobTxList,
 	nonce *uint64,
 	assignment *encoding.ProverAssignment,
 	assignedProver common.Address,
 	maxFee *big.Int,
+	tip *big.Int,
 	isReplacement bool,
 ) (*types.Transaction, error) {
-	// Propose the transactions list
-	opts, err := getTxOpts(ctx, p.rpc.L1, p.proposerPrivKey, p.rpc.L1ChainID, maxFee)
+	// Propose the transactions list, the tip is paid along with the prover fee through the AssignmentHook,
+	// when the prover fee is paid in an ERC-20 token, the AssignmentHook transfers it from the proposer instead.
+	value := new(big.Int).Add(maxFee, tip)
+	if p.proverSelector.FeeToken() != rpc.ZeroAddress {
+		value = tip
+	}
+	opts, err := getTxOpts(ctx, p.rpc.L1, p.proposerSigner, p.rpc.L1ChainID, value)
 	if err != nil {
 		return nil, err
 	}
-	if nonce != nil {
+	if nonce != nil && p.dryRun == nil {
 		opts.Nonce = new(big.Int).SetUint64(*nonce)
 	}
+	opts.NoSend = p.dryRun != nil
 	if p.proposeBlockTxGasLimit != nil {
 		opts.GasLimit = *p.proposeBlockTxGasLimit
 	}
@@ -385,24 +623,12 @@ func (p *Proposer) sendProposeBlockTx(
 		parentMetaHash = parent.MetaHash
 	}
 
-	hookCalls := make([]encoding.HookCall, 0)
-
-	// initially just use the AssignmentHook default.
-	// TODO: flag for additional hook addresses and data.
-	hookInputData, err := encoding.EncodeAssignmentHookInput(&encoding.AssignmentHookInput{
-		Assignment: assignment,
-		Tip:        common.Big0, // TODO: flag for tip
-	})
+	hookCalls, err := p.buildHookCalls(assignment, assignedProver, tip)
 	if err != nil {
 		return nil, err
 	}
 
-	hookCalls = append(hookCalls, encoding.HookCall{
-		Hook: p.cfg.AssignmentHookAddress,
-		Data: hookInputData,
-	})
-
-	encodedParams, err := encoding.EncodeBlockParams(&encoding.BlockParams{
+	blockParams := &encoding.BlockParams{
 		AssignedProver:    assignedProver,
 		ExtraData:         rpc.StringToBytes32(p.cfg.ExtraData),
 		TxListByteOffset:  common.Big0,
@@ -411,39 +637,164 @@ func (p *Proposer) sendProposeBlockTx(
 		CacheBlobForReuse: false,
 		ParentMetaHash:    parentMetaHash,
 		HookCalls:         hookCalls,
-	})
+	}
+
+	// Calldata mode.
+	if blob == nil {
+		encodedParams, err := encoding.EncodeBlockParams(blockParams)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := encoding.TaikoL1ABI.Pack("proposeBlock", encodedParams, txListBytes)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.simulateProposeBlock(ctx, opts, data, nil); err != nil {
+			return nil, err
+		}
+
+		proposeTx, err := p.rpc.TaikoL1.ProposeBlock(opts, encodedParams, txListBytes)
+		if err != nil {
+			return nil, encoding.TryParsingCustomError(err)
+		}
+
+		return proposeTx, nil
+	}
+
+	// Blob mode, the protocol will read the blob hash of a newly carried blob through the BLOBHASH
+	// opcode, so `BlobHash` is only set when reusing a blob which has already been cached.
+	blockParams.TxListByteOffset = new(big.Int).SetUint64(blob.offset)
+	blockParams.TxListByteSize = new(big.Int).SetUint64(blob.size)
+	if blob.sidecar == nil {
+		blockParams.BlobHash = blob.blobHash
+	} else {
+		blockParams.CacheBlobForReuse = blob.cacheForReuse
+	}
+
+	encodedParams, err := encoding.EncodeBlockParams(blockParams)
 	if err != nil {
 		return nil, err
 	}
 
-	proposeTx, err := p.rpc.TaikoL1.ProposeBlock(
-		opts,
-		encodedParams,
-		txListBytes,
-	)
+	data, err := encoding.TaikoL1ABI.Pack("proposeBlock", encodedParams, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.simulateProposeBlock(ctx, opts, data, blob.sidecar); err != nil {
+		return nil, err
+	}
+
+	var proposeTx *types.Transaction
+	if blob.sidecar == nil {
+		proposeTx, err = p.rpc.TaikoL1.ProposeBlock(opts, encodedParams, []byte{})
+	} else {
+		proposeTx, err = p.rpc.TransactBlobTx(ctx, opts, p.cfg.TaikoL1Address, data, blob.sidecar)
+	}
 	if err != nil {
 		return nil, encoding.TryParsingCustomError(err)
 	}
 
+	log.Info(
+		"Blob-backed proposeBlock transaction sent",
+		"hash", proposeTx.Hash(),
+		"blobHash", blob.blobHash,
+		"reuse", blob.sidecar == nil,
+		"offset", blob.offset,
+		"size", blob.size,
+		"cacheBlobForReuse", blockParams.CacheBlobForReuse,
+	)
+
 	return proposeTx, nil
 }
 
-// ProposeTxList proposes the given transactions list to TaikoL1 smart contract.
+// ProposeTxList encodes the given RLP encoded transactions list with the configured codec, and proposes
+// it to TaikoL1 smart contract.
 func (p *Proposer) ProposeTxList(
 	ctx context.Context,
 	txListBytes []byte,
 	txNum uint,
 	nonce *uint64,
 ) error {
-	assignment, proverAddress, maxFee, err := p.proverSelector.AssignProver(
-		ctx,
-		p.tierFees,
-		crypto.Keccak256Hash(txListBytes),
-	)
+	encoded, err := p.txListCodec.Encode(txListBytes)
+	if err != nil {
+		return fmt.Errorf("failed to encode transactions list: %w", err)
+	}
+
+	return p.proposeEncodedTxList(ctx, encoded, txNum, nonce)
+}
+
+// proposeEncodedTxList proposes the given transactions list which has already been encoded by the
+// configured codec, through a new blob if blob is allowed, otherwise through calldata.
+func (p *Proposer) proposeEncodedTxList(
+	ctx context.Context,
+	txListBytes []byte,
+	txNum uint,
+	nonce *uint64,
+) error {
+	var blob *blobTxList
+	if p.cfg.BlobAllowed {
+		var err error
+		if blob, err = newBlobTxList(txListBytes, p.cfg.CacheBlobForReuse); err != nil {
+			return err
+		}
+	}
+
+	return p.proposeTxList(ctx, txListBytes, txNum, nonce, blob)
+}
+
+// proposeTxList proposes the given transactions list to TaikoL1 smart contract, if the given blob is not nil,
+// the transactions list will be proposed through that blob, otherwise through calldata.
+func (p *Proposer) proposeTxList(
+	ctx context.Context,
+	txListBytes []byte,
+	txNum uint,
+	nonce *uint64,
+	blob *blobTxList,
+) error {
+	assignment, proverAddress, maxFee, err := p.assignProver(ctx, txListBytes, blob)
 	if err != nil {
 		return err
 	}
 
+	return p.sendTxList(ctx, txListBytes, txNum, nonce, blob, assignment, proverAddress, maxFee)
+}
+
+// assignProver requests a prover assignment for the given transactions list.
+func (p *Proposer) assignProver(
+	ctx context.Context,
+	txListBytes []byte,
+	blob *blobTxList,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	// When proposing with a blob, the protocol uses the blob versioned hash as `meta.blobHash`,
+	// which is the hash the prover assignment signature should be based on.
+	txListHash := crypto.Keccak256Hash(txListBytes)
+	if blob != nil {
+		txListHash = blob.blobHash
+	}
+
+	return p.proverSelector.AssignProver(ctx, p.tierFees, txListHash)
+}
+
+// sendTxList sends a TaikoL1.proposeBlock transaction with the given prover assignment, retries
+// when failed, and waits for its receipt.
+func (p *Proposer) sendTxList(
+	ctx context.Context,
+	txListBytes []byte,
+	txNum uint,
+	nonce *uint64,
+	blob *blobTxList,
+	assignment *encoding.ProverAssignment,
+	proverAddress common.Address,
+	maxFee *big.Int,
+) error {
+	tip, err := p.proposerTip(ctx, txListBytes)
+	if err != nil {
+		p.releaseNonce(nonce)
+		return err
+	}
+
 	var (
 		isReplacement bool
 		tx            *types.Transaction
@@ -453,19 +804,40 @@ func (p *Proposer) ProposeTxList(
 			if ctx.Err() != nil {
 				return nil
 			}
+			// The leadership may be lost during a long proposing epoch, then stop sending immediately.
+			if p.elector != nil && !p.elector.IsLeader() {
+				return backoff.Permanent(errLeadershipLost)
+			}
 			if tx, err = p.sendProposeBlockTx(
 				ctx,
 				txListBytes,
+				blob,
 				nonce,
 				assignment,
 				proverAddress,
 				maxFee,
+				tip,
 				isReplacement,
 			); err != nil {
-				log.Warn("Failed to send taikoL1.proposeBlock transaction", "error", encoding.TryParsingCustomError(err))
+				err = encoding.TryParsingCustomError(err)
+				action := classifyProposeBlockError(err)
+				log.Warn("Failed to send taikoL1.proposeBlock transaction", "action", action, "error", err)
 				if strings.Contains(err.Error(), core.ErrNonceTooLow.Error()) {
 					return nil
 				}
+				switch action {
+				case proposeBlockAbort:
+					return backoff.Permanent(err)
+				case proposeBlockReassignProver:
+					var assignErr error
+					if assignment, proverAddress, maxFee, assignErr = p.assignProver(
+						ctx,
+						txListBytes,
+						blob,
+					); assignErr != nil {
+						log.Warn("Failed to reassign prover", "error", assignErr)
+					}
+				}
 				if strings.Contains(err.Error(), txpool.ErrReplaceUnderpriced.Error()) {
 					isReplacement = true
 				} else {
@@ -481,15 +853,34 @@ func (p *Proposer) ProposeTxList(
 			uint64(maxSendProposeBlockTxRetry),
 		),
 	); err != nil {
+		p.releaseNonce(nonce)
 		return err
 	}
 	if ctx.Err() != nil {
+		p.releaseNonce(nonce)
 		return ctx.Err()
 	}
-	if err != nil {
+	if err != nil || tx == nil {
+		p.releaseNonce(nonce)
 		return err
 	}
 
+	if p.dryRun != nil {
+		return p.dryRun.record(newDryRunProposal(
+			tx,
+			crypto.Keccak256Hash(txListBytes),
+			blob,
+			len(txListBytes),
+			txNum,
+			assignment,
+			proverAddress,
+			maxFee,
+			tip,
+		))
+	}
+
+	p.nonceManager.Sent(tx)
+
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, p.waitReceiptTimeout)
 	defer cancel()
 
@@ -505,6 +896,13 @@ func (p *Proposer) ProposeTxList(
 	return nil
 }
 
+// releaseNonce releases the given reserved nonce, if nothing has been sent with it.
+func (p *Proposer) releaseNonce(nonce *uint64) {
+	if nonce != nil && p.dryRun == nil {
+		p.nonceManager.Release(*nonce)
+	}
+}
+
 // ProposeEmptyBlockOp performs a proposing one empty block operation.
 func (p *Proposer) ProposeEmptyBlockOp(ctx context.Context) error {
 	emptyTxListBytes, err := rlp.EncodeToBytes(types.Transactions{})
@@ -521,7 +919,9 @@ func (p *Proposer) updateProposingTicker() {
 	}
 
 	var duration time.Duration
-	if p.proposingInterval != nil {
+	if p.adaptiveInterval != nil {
+		duration = p.adaptiveInterval.Current()
+	} else if p.proposingInterval != nil {
 		duration = *p.proposingInterval
 	} else {
 		// Random number between 12 - 120
@@ -570,18 +970,38 @@ func (p *Proposer) initTierFees() error {
 	return nil
 }
 
-// getTxOpts creates a bind.TransactOpts instance using the given private key.
+// newTxListPolicies creates the transactions list policies based on the given configurations, the
+// custom policies are applied after the built-in ones.
+func newTxListPolicies(cfg *Config) []policy.TxListPolicy {
+	var policies []policy.TxListPolicy
+	if len(cfg.AllowedSenders) != 0 || len(cfg.DeniedSenders) != 0 {
+		policies = append(policies, policy.NewSenderPolicy(cfg.AllowedSenders, cfg.DeniedSenders))
+	}
+	if len(cfg.DeniedContracts) != 0 {
+		policies = append(policies, policy.NewContractPolicy(cfg.DeniedContracts))
+	}
+	if cfg.MinTxTip != nil && cfg.MinTxTip.Sign() > 0 {
+		policies = append(policies, policy.NewMinTipPolicy(cfg.MinTxTip))
+	}
+	if cfg.MaxTxsPerSender != 0 {
+		policies = append(policies, policy.NewSenderCapPolicy(cfg.MaxTxsPerSender))
+	}
+	if cfg.FeePriorityOrdering {
+		policies = append(policies, policy.NewFeePriorityPolicy())
+	}
+
+	return append(policies, cfg.TxListPolicies...)
+}
+
+// getTxOpts creates a bind.TransactOpts instance using the given signer.
 func getTxOpts(
 	ctx context.Context,
 	cli *rpc.EthClient,
-	privKey *ecdsa.PrivateKey,
+	s signer.Signer,
 	chainID *big.Int,
 	fee *big.Int,
 ) (*bind.TransactOpts, error) {
-	opts, err := bind.NewKeyedTransactorWithChainID(privKey, chainID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate prepareBlock transaction options: %w", err)
-	}
+	opts := signer.NewTransactOpts(ctx, s, chainID)
 
 	gasTipCap, err := cli.SuggestGasTipCap(ctx)
 	if err != nil {
@@ -151,7 +151,7 @@ func (s *ProposerTestSuite) TestSendProposeBlockTx() {
 	opts, err := getTxOpts(
 		context.Background(),
 		s.p.rpc.L1,
-		s.p.proposerPrivKey,
+		s.p.proposerSigner,
 		s.RPCClient.L1ChainID,
 		fee,
 	)
@@ -173,7 +173,7 @@ func (s *ProposerTestSuite) TestSendProposeBlockTx() {
 	s.SetL1Automine(false)
 	defer s.SetL1Automine(true)
 
-	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(s.RPCClient.L1ChainID), s.p.proposerPrivKey)
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(s.RPCClient.L1ChainID), s.p.cfg.L1ProposerPrivKey)
 	s.Nil(err)
 	s.Nil(s.RPCClient.L1.SendTransaction(context.Background(), signedTx))
 
@@ -191,10 +191,12 @@ func (s *ProposerTestSuite) TestSendProposeBlockTx() {
 	newTx, err := s.p.sendProposeBlockTx(
 		context.Background(),
 		encoded,
+		nil,
 		&nonce,
 		signedAssignment,
 		proverAddress,
 		fee,
+		common.Big0,
 		true,
 	)
 	s.Nil(err)
@@ -218,6 +220,10 @@ func (s *ProposerTestSuite) TestUpdateProposingTicker() {
 
 	s.p.proposingInterval = nil
 	s.NotPanics(s.p.updateProposingTicker)
+
+	s.p.adaptiveInterval = newAdaptiveInterval(oneHour, time.Minute, 2*oneHour, 150)
+	s.NotPanics(s.p.updateProposingTicker)
+	s.p.adaptiveInterval = nil
 }
 
 func (s *ProposerTestSuite) TestStartClose() {
@@ -0,0 +1,116 @@
+package selector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+// verifyAssignment verifies the given signed prover assignment locally before proposing, to make sure
+// the AssignmentHook contract will not revert the proposing transaction because of it. It rebuilds the
+// assignment payload, checks its signature against the claimed prover address, and checks the expiry,
+// MaxBlockId and MaxProposedIn of the assignment against the current L1 head.
+func (s *ETHFeeEOASelector) verifyAssignment(
+	ctx context.Context,
+	assignment *encoding.ProverAssignment,
+	proverAddress common.Address,
+	txListHash common.Hash,
+) error {
+	payload, err := encoding.EncodeProverAssignmentPayload(
+		s.protocolConfigs.ChainId,
+		s.taikoL1Address,
+		s.assignmentHookAddress,
+		txListHash,
+		assignment.FeeToken,
+		assignment.Expiry,
+		assignment.MaxBlockId,
+		assignment.MaxProposedIn,
+		assignment.TierFees,
+	)
+	if err != nil {
+		return err
+	}
+	hash := crypto.Keccak256Hash(payload)
+
+	contractProver, err := isContract(ctx, s.rpc.L1, proverAddress)
+	if err != nil {
+		return err
+	}
+	if contractProver {
+		if err := verifyContractSignature(ctx, s.rpc.L1, proverAddress, hash, assignment.Signature); err != nil {
+			return err
+		}
+	} else if err := verifyEOASignature(hash, assignment.Signature, proverAddress); err != nil {
+		return err
+	}
+
+	l1Head, err := s.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	state, err := s.rpc.GetProtocolStateVariables(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return err
+	}
+
+	return checkAssignmentBounds(assignment, l1Head, state.B.NumBlocks)
+}
+
+// verifyEOASignature checks whether the given signature, whose recovery ID has been converted to the
+// one solidity can recover, is signed by the given prover.
+func verifyEOASignature(hash common.Hash, sig []byte, proverAddress common.Address) error {
+	if len(sig) != crypto.SignatureLength {
+		return fmt.Errorf("invalid assignment signature length %d", len(sig))
+	}
+
+	sig = common.CopyBytes(sig)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return err
+	}
+
+	if crypto.PubkeyToAddress(*pubKey) != proverAddress {
+		return fmt.Errorf(
+			"assigned prover signature did not recover to provided prover address %s != %s",
+			crypto.PubkeyToAddress(*pubKey).Hex(),
+			proverAddress.Hex(),
+		)
+	}
+
+	return nil
+}
+
+// checkAssignmentBounds checks whether the given assignment is still valid for the block proposed in the
+// next L1 block, whose ID will be nextBlockID.
+func checkAssignmentBounds(assignment *encoding.ProverAssignment, l1Head *types.Header, nextBlockID uint64) error {
+	if l1Head.Time >= assignment.Expiry {
+		return fmt.Errorf("prover assignment expired, expiry %d, L1 head time %d", assignment.Expiry, l1Head.Time)
+	}
+
+	if assignment.MaxBlockId != 0 && nextBlockID > assignment.MaxBlockId {
+		return fmt.Errorf(
+			"prover assignment max block ID exceeded, maxBlockId %d, next block ID %d",
+			assignment.MaxBlockId,
+			nextBlockID,
+		)
+	}
+
+	if assignment.MaxProposedIn != 0 && l1Head.Number.Uint64()+1 > assignment.MaxProposedIn {
+		return fmt.Errorf(
+			"prover assignment max proposed in exceeded, maxProposedIn %d, next L1 block %d",
+			assignment.MaxProposedIn,
+			l1Head.Number.Uint64()+1,
+		)
+	}
+
+	return nil
+}
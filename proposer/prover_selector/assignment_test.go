@@ -0,0 +1,67 @@
+package selector
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func TestVerifyEOASignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	hash := crypto.Keccak256Hash([]byte("PROVER_ASSIGNMENT"))
+	sig, err := crypto.Sign(hash.Bytes(), key)
+	require.Nil(t, err)
+	sig[crypto.RecoveryIDOffset] += 27
+
+	require.Nil(t, verifyEOASignature(hash, sig, crypto.PubkeyToAddress(key.PublicKey)))
+	require.GreaterOrEqual(t, sig[crypto.RecoveryIDOffset], uint8(27))
+	require.ErrorContains(t, verifyEOASignature(hash, sig, testProverA), "did not recover")
+	require.ErrorContains(t, verifyEOASignature(hash, sig[:64], testProverA), "invalid assignment signature length")
+}
+
+func TestCheckAssignmentBounds(t *testing.T) {
+	l1Head := &types.Header{Number: big.NewInt(100), Time: 1000}
+
+	require.Nil(t, checkAssignmentBounds(&encoding.ProverAssignment{Expiry: 1001}, l1Head, 10))
+	require.Nil(t, checkAssignmentBounds(
+		&encoding.ProverAssignment{Expiry: 1001, MaxBlockId: 10, MaxProposedIn: 101},
+		l1Head,
+		10,
+	))
+	require.ErrorContains(
+		t,
+		checkAssignmentBounds(&encoding.ProverAssignment{Expiry: 1000}, l1Head, 10),
+		"prover assignment expired",
+	)
+	require.ErrorContains(
+		t,
+		checkAssignmentBounds(&encoding.ProverAssignment{Expiry: 1001, MaxBlockId: 9}, l1Head, 10),
+		"max block ID exceeded",
+	)
+	require.ErrorContains(
+		t,
+		checkAssignmentBounds(&encoding.ProverAssignment{Expiry: 1001, MaxProposedIn: 100}, l1Head, 10),
+		"max proposed in exceeded",
+	)
+}
+
+func TestVerifyEOASignatureWrongHash(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	sig, err := crypto.Sign(crypto.Keccak256([]byte("PROVER_ASSIGNMENT")), key)
+	require.Nil(t, err)
+
+	require.ErrorContains(
+		t,
+		verifyEOASignature(common.BigToHash(common.Big1), sig, crypto.PubkeyToAddress(key.PublicKey)),
+		"did not recover",
+	)
+}
@@ -0,0 +1,181 @@
+package selector
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/prover/server"
+)
+
+// bid is a signed assignment response from a prover endpoint in an auction round.
+type bid struct {
+	endpoint   *url.URL
+	assignment *encoding.ProverAssignment
+	prover     common.Address
+	maxFee     *big.Int
+	latency    time.Duration
+}
+
+// auction asks all prover endpoints at once for their quotes, sends each of them an assignment request with
+// the given tier fees lowered to its quoted ones, collects the signed responses within the auction deadline,
+// and picks the valid bid with the lowest max tier fee, the endpoint reputation only breaks the ties.
+func (s *ETHFeeEOASelector) auction(
+	ctx context.Context,
+	feeToken common.Address,
+	expiry uint64,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+	guardianProverAddress common.Address,
+) *bid {
+	var (
+		endpoints = s.reputation.Sort(s.ProverEndpoints())
+		bids      = make([]*bid, len(endpoints))
+		wg        sync.WaitGroup
+	)
+
+	ctxDeadline, cancel := context.WithTimeout(ctx, s.auctionDeadline)
+	defer cancel()
+
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint *url.URL) {
+			defer wg.Done()
+
+			requestedAt := time.Now()
+
+			// The endpoints which don't serve quotes still bid with the given tier fees.
+			var (
+				bidFees        = tierFees
+				quoteSignature []byte
+			)
+			quote, err := quoteProver(ctxDeadline, endpoint, feeToken, s.auctionDeadline)
+			if err != nil {
+				log.Debug("Failed to fetch prover quote", "endpoint", endpoint, "error", err)
+			} else {
+				bidFees, quoteSignature = quotedTierFees(tierFees, feeToken, quote)
+			}
+
+			assignment, proverAddress, err := assignProver(
+				ctxDeadline,
+				s.protocolConfigs.ChainId,
+				endpoint,
+				feeToken,
+				expiry,
+				bidFees,
+				quoteSignature,
+				s.taikoL1Address,
+				s.assignmentHookAddress,
+				txListHash,
+				s.auctionDeadline,
+				guardianProverAddress,
+			)
+			if err != nil {
+				log.Warn("Failed to collect prover bid", "endpoint", endpoint, "error", err)
+				s.reputation.OnAssignmentFailed(endpoint)
+				return
+			}
+			if err := s.verifyAssignment(ctx, assignment, proverAddress, txListHash); err != nil {
+				log.Warn("Invalid prover bid", "endpoint", endpoint, "prover", proverAddress, "error", err)
+				s.reputation.OnAssignmentFailed(endpoint)
+				return
+			}
+			latency := time.Since(requestedAt)
+			s.reputation.OnAssignmentAccepted(endpoint, latency, s.auctionDeadline)
+
+			ok, err := rpc.CheckProverBalance(
+				ctx,
+				s.rpc,
+				proverAddress,
+				s.assignmentHookAddress,
+				s.protocolConfigs.LivenessBond,
+			)
+			if err != nil {
+				log.Warn("Failed to check prover balance", "endpoint", endpoint, "error", err)
+				return
+			}
+			if !ok {
+				return
+			}
+
+			bids[i] = &bid{
+				endpoint:   endpoint,
+				assignment: assignment,
+				prover:     proverAddress,
+				maxFee:     maxTierFee(assignment.TierFees),
+				latency:    latency,
+			}
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	b := lowestBid(bids)
+	if b != nil {
+		log.Info(
+			"Prover auction won",
+			"endpoint", b.endpoint,
+			"prover", b.prover,
+			"maxFee", b.maxFee,
+			"latency", b.latency,
+			"endpoints", len(endpoints),
+		)
+	}
+
+	return b
+}
+
+// quotedTierFees returns the given tier fees, each lowered to the prover's quoted fee of the same tier
+// if that is cheaper, and the quote signature to get the quoted fees honored. The quotes in other fee
+// tokens or already expired are ignored.
+func quotedTierFees(
+	tierFees []encoding.TierFee,
+	feeToken common.Address,
+	quote *server.QuoteResponse,
+) ([]encoding.TierFee, []byte) {
+	if quote.FeeToken != feeToken || time.Now().Unix() >= int64(quote.Expiry) {
+		return tierFees, nil
+	}
+
+	quoted := make(map[uint16]*big.Int, len(quote.TierFees))
+	for _, tierFee := range quote.TierFees {
+		if tierFee.Fee != nil {
+			quoted[tierFee.Tier] = tierFee.Fee
+		}
+	}
+
+	var (
+		fees    = make([]encoding.TierFee, len(tierFees))
+		lowered = false
+	)
+	for i, tierFee := range tierFees {
+		fees[i] = tierFee
+		if fee, ok := quoted[tierFee.Tier]; ok && fee.Cmp(tierFee.Fee) < 0 {
+			fees[i].Fee = fee
+			lowered = true
+		}
+	}
+	if !lowered {
+		return tierFees, nil
+	}
+
+	return fees, quote.Signature
+}
+
+// lowestBid returns the valid bid with the lowest max tier fee, the given bids are in the descending
+// reputation order of their endpoints, so the bid from the most reputable endpoint wins the ties.
+func lowestBid(bids []*bid) *bid {
+	var lowest *bid
+	for _, b := range bids {
+		if b != nil && (lowest == nil || b.maxFee.Cmp(lowest.maxFee) < 0) {
+			lowest = b
+		}
+	}
+
+	return lowest
+}
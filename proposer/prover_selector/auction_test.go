@@ -0,0 +1,70 @@
+package selector
+
+import (
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/prover/server"
+)
+
+func TestQuotedTierFees(t *testing.T) {
+	var (
+		feeToken = common.BigToAddress(common.Big1)
+		tierFees = []encoding.TierFee{
+			{Tier: encoding.TierOptimisticID, Fee: big.NewInt(100)},
+			{Tier: encoding.TierSgxID, Fee: big.NewInt(200)},
+			{Tier: encoding.TierPseZkevmID, Fee: big.NewInt(300)},
+		}
+		quote = &server.QuoteResponse{
+			FeeToken: feeToken,
+			TierFees: []encoding.TierFee{
+				{Tier: encoding.TierOptimisticID, Fee: big.NewInt(50)},
+				{Tier: encoding.TierSgxID, Fee: big.NewInt(250)},
+			},
+			Expiry:    uint64(time.Now().Add(time.Minute).Unix()),
+			Signature: []byte{0x01},
+		}
+	)
+
+	// Only the tier fees quoted cheaper are lowered.
+	fees, sig := quotedTierFees(tierFees, feeToken, quote)
+	require.Equal(t, quote.Signature, sig)
+	require.Equal(t, big.NewInt(50), fees[0].Fee)
+	require.Equal(t, big.NewInt(200), fees[1].Fee)
+	require.Equal(t, big.NewInt(300), fees[2].Fee)
+	require.Equal(t, big.NewInt(100), tierFees[0].Fee)
+
+	// The quotes in another fee token are ignored.
+	fees, sig = quotedTierFees(tierFees, common.Address{}, quote)
+	require.Nil(t, sig)
+	require.Equal(t, tierFees, fees)
+
+	// The expired quotes are ignored.
+	quote.Expiry = uint64(time.Now().Add(-time.Minute).Unix())
+	fees, sig = quotedTierFees(tierFees, feeToken, quote)
+	require.Nil(t, sig)
+	require.Equal(t, tierFees, fees)
+}
+
+func TestLowestBid(t *testing.T) {
+	newBid := func(endpoint string, maxFee int64) *bid {
+		u, err := url.Parse(endpoint)
+		require.Nil(t, err)
+		return &bid{endpoint: u, maxFee: big.NewInt(maxFee)}
+	}
+
+	require.Nil(t, lowestBid([]*bid{nil, nil}))
+
+	// The cheapest bid wins, even from a less reputable endpoint.
+	b := lowestBid([]*bid{newBid("http://a", 200), nil, newBid("http://c", 100)})
+	require.Equal(t, "http://c", b.endpoint.String())
+
+	// The most reputable endpoint wins the ties.
+	b = lowestBid([]*bid{nil, newBid("http://b", 100), newBid("http://c", 100)})
+	require.Equal(t, "http://b", b.endpoint.String())
+}
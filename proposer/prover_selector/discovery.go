@@ -0,0 +1,196 @@
+package selector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/go-resty/resty/v2"
+	"github.com/taikoxyz/taiko-client/prover/server"
+)
+
+// ProverMetadata is the prover endpoint metadata returned by a prover registry, or listed in a prover list file.
+type ProverMetadata struct {
+	Endpoint string `json:"endpoint"`
+	// Optional, if set, the prover address reported by the endpoint's `/status` API must match it.
+	Prover *common.Address `json:"prover,omitempty"`
+}
+
+// DiscoveryConfig contains all configurations for the prover endpoints discovery.
+type DiscoveryConfig struct {
+	// URL of the prover registry, which returns a JSON list of ProverMetadata.
+	RegistryURL string
+	// Path of a file containing a JSON list of ProverMetadata, which will be reloaded on change.
+	FilePath       string
+	Interval       time.Duration
+	RequestTimeout time.Duration
+}
+
+// Discovery discovers prover endpoints from a prover registry or a prover list file, and only keeps
+// the endpoints which pass the `/status` health check.
+type Discovery struct {
+	cfg         *DiscoveryConfig
+	endpoints   []*url.URL
+	fileModTime time.Time
+	provers     []*ProverMetadata
+	mutex       sync.RWMutex
+}
+
+// NewDiscovery creates a new Discovery instance.
+func NewDiscovery(cfg *DiscoveryConfig) (*Discovery, error) {
+	if (cfg.RegistryURL == "") == (cfg.FilePath == "") {
+		return nil, errors.New("exactly one of prover registry URL and prover list file should be set")
+	}
+	if cfg.Interval == 0 {
+		return nil, errors.New("empty prover discovery interval")
+	}
+
+	return &Discovery{cfg: cfg}, nil
+}
+
+// Endpoints returns the currently discovered healthy prover endpoints.
+func (d *Discovery) Endpoints() []*url.URL {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.endpoints
+}
+
+// Start keeps refreshing the discovered prover endpoints, until the given context is done.
+func (d *Discovery) Start(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Refresh(ctx); err != nil {
+			log.Warn("Failed to refresh prover endpoints", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh fetches the latest prover list, and updates the discovered endpoints with the healthy ones.
+func (d *Discovery) Refresh(ctx context.Context) error {
+	provers, err := d.fetchProvers(ctx)
+	if err != nil {
+		return err
+	}
+
+	var endpoints []*url.URL
+	for _, prover := range provers {
+		endpoint, err := d.checkEndpoint(ctx, prover)
+		if err != nil {
+			log.Warn("Drop unhealthy prover endpoint", "endpoint", prover.Endpoint, "error", err)
+			continue
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	log.Info("Prover endpoints discovered", "total", len(provers), "healthy", len(endpoints))
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.endpoints = endpoints
+
+	return nil
+}
+
+// fetchProvers fetches the prover list from the prover registry or the prover list file.
+func (d *Discovery) fetchProvers(ctx context.Context) ([]*ProverMetadata, error) {
+	var provers []*ProverMetadata
+
+	if d.cfg.RegistryURL != "" {
+		ctxTimeout, cancel := context.WithTimeout(ctx, d.cfg.RequestTimeout)
+		defer cancel()
+
+		resp, err := resty.New().R().
+			SetContext(ctxTimeout).
+			SetHeader("Accept", "application/json").
+			SetResult(&provers).
+			Get(d.cfg.RegistryURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch prover registry: %w", err)
+		}
+		if !resp.IsSuccess() {
+			return nil, fmt.Errorf("unsuccessful prover registry response %d", resp.StatusCode())
+		}
+
+		return provers, nil
+	}
+
+	info, err := os.Stat(d.cfg.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat prover list file: %w", err)
+	}
+	// Only reload the file when it has been changed, the previously loaded endpoints are still
+	// checked every time though.
+	if d.provers != nil && info.ModTime().Equal(d.fileModTime) {
+		return d.provers, nil
+	}
+
+	data, err := os.ReadFile(d.cfg.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prover list file: %w", err)
+	}
+	if err := json.Unmarshal(data, &provers); err != nil {
+		return nil, fmt.Errorf("failed to decode prover list file: %w", err)
+	}
+
+	log.Info("Prover list file reloaded", "path", d.cfg.FilePath, "provers", len(provers))
+	d.provers = provers
+	d.fileModTime = info.ModTime()
+
+	return provers, nil
+}
+
+// checkEndpoint validates the given prover endpoint through its `/status` API.
+func (d *Discovery) checkEndpoint(ctx context.Context, prover *ProverMetadata) (*url.URL, error) {
+	endpoint, err := url.Parse(prover.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.Scheme != httpScheme && endpoint.Scheme != httpsScheme {
+		return nil, fmt.Errorf("invalid prover endpoint %s", endpoint)
+	}
+
+	requestURL, err := url.JoinPath(endpoint.String(), "/status")
+	if err != nil {
+		return nil, err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, d.cfg.RequestTimeout)
+	defer cancel()
+
+	status := new(server.Status)
+	resp, err := resty.New().R().
+		SetContext(ctxTimeout).
+		SetHeader("Accept", "application/json").
+		SetResult(status).
+		Get(requestURL)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("unsuccessful response %d", resp.StatusCode())
+	}
+	if !common.IsHexAddress(status.Prover) {
+		return nil, fmt.Errorf("invalid prover address in status: %s", status.Prover)
+	}
+	if prover.Prover != nil && common.HexToAddress(status.Prover) != *prover.Prover {
+		return nil, fmt.Errorf("prover address mismatch %s != %s", status.Prover, prover.Prover.Hex())
+	}
+
+	return endpoint, nil
+}
@@ -0,0 +1,92 @@
+package selector
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/prover/server"
+)
+
+// newStubProverServer starts a stub prover server, which reports the given prover address in its status.
+func newStubProverServer(t *testing.T, prover common.Address, healthy bool) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.Nil(t, json.NewEncoder(w).Encode(&server.Status{Prover: prover.Hex()}))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestDiscoveryRegistry(t *testing.T) {
+	var (
+		healthy   = newStubProverServer(t, testProverA, true)
+		unhealthy = newStubProverServer(t, testProverB, true)
+		down      = newStubProverServer(t, testProverB, false)
+		provers   = []*ProverMetadata{
+			{Endpoint: healthy.URL, Prover: &testProverA},
+			{Endpoint: unhealthy.URL, Prover: &testProverA},
+			{Endpoint: down.URL},
+			{Endpoint: "ftp://prover"},
+		}
+	)
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.Nil(t, json.NewEncoder(w).Encode(provers))
+	}))
+	t.Cleanup(registry.Close)
+
+	d, err := NewDiscovery(&DiscoveryConfig{RegistryURL: registry.URL, Interval: time.Minute, RequestTimeout: time.Second})
+	require.Nil(t, err)
+	require.Nil(t, d.Refresh(context.Background()))
+	require.Len(t, d.Endpoints(), 1)
+	require.Equal(t, healthy.URL, d.Endpoints()[0].String())
+}
+
+func TestDiscoveryFile(t *testing.T) {
+	var (
+		proverA  = newStubProverServer(t, testProverA, true)
+		proverB  = newStubProverServer(t, testProverB, true)
+		filePath = filepath.Join(t.TempDir(), "provers.json")
+	)
+	writeProvers := func(provers []*ProverMetadata, modTime time.Time) {
+		data, err := json.Marshal(provers)
+		require.Nil(t, err)
+		require.Nil(t, os.WriteFile(filePath, data, 0600))
+		require.Nil(t, os.Chtimes(filePath, modTime, modTime))
+	}
+
+	d, err := NewDiscovery(&DiscoveryConfig{FilePath: filePath, Interval: time.Minute, RequestTimeout: time.Second})
+	require.Nil(t, err)
+
+	now := time.Now()
+	writeProvers([]*ProverMetadata{{Endpoint: proverA.URL}}, now)
+	require.Nil(t, d.Refresh(context.Background()))
+	require.Len(t, d.Endpoints(), 1)
+	require.Equal(t, proverA.URL, d.Endpoints()[0].String())
+
+	writeProvers([]*ProverMetadata{{Endpoint: proverA.URL}, {Endpoint: proverB.URL}}, now.Add(time.Second))
+	require.Nil(t, d.Refresh(context.Background()))
+	require.Len(t, d.Endpoints(), 2)
+}
+
+func TestNewDiscoveryConfigErr(t *testing.T) {
+	_, err := NewDiscovery(&DiscoveryConfig{Interval: time.Minute})
+	require.NotNil(t, err)
+	_, err = NewDiscovery(&DiscoveryConfig{RegistryURL: "http://registry", FilePath: "provers.json"})
+	require.NotNil(t, err)
+	_, err = NewDiscovery(&DiscoveryConfig{RegistryURL: "http://registry"})
+	require.ErrorContains(t, err, "empty prover discovery interval")
+}
@@ -0,0 +1,61 @@
+package selector
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+var (
+	// EIP-1271 `isValidSignature(bytes32,bytes)` method selector, which is also the magic value
+	// returned by the method when the signature is valid.
+	eip1271MagicValue    = crypto.Keccak256([]byte("isValidSignature(bytes32,bytes)"))[:4]
+	bytes32Type, _       = abi.NewType("bytes32", "", nil)
+	bytesType, _         = abi.NewType("bytes", "", nil)
+	isValidSignatureArgs = abi.Arguments{{Type: bytes32Type}, {Type: bytesType}}
+)
+
+// isContract checks whether the given account is a contract.
+func isContract(ctx context.Context, cli *rpc.EthClient, account common.Address) (bool, error) {
+	code, err := cli.CodeAt(ctx, account, nil)
+	if err != nil {
+		return false, err
+	}
+	return len(code) != 0, nil
+}
+
+// verifyContractSignature verifies the given signature through the EIP-1271 `isValidSignature` method
+// of the given contract.
+func verifyContractSignature(
+	ctx context.Context,
+	cli *rpc.EthClient,
+	contract common.Address,
+	hash common.Hash,
+	sig []byte,
+) error {
+	args, err := isValidSignatureArgs.Pack(hash, sig)
+	if err != nil {
+		return err
+	}
+
+	result, err := cli.CallContract(ctx, ethereum.CallMsg{
+		To:   &contract,
+		Data: append(common.CopyBytes(eip1271MagicValue), args...),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to call isValidSignature of prover contract %s: %w", contract.Hex(), err)
+	}
+
+	// The returned bytes4 is left aligned in a 32 bytes word.
+	if len(result) < len(eip1271MagicValue) || !bytes.Equal(result[:len(eip1271MagicValue)], eip1271MagicValue) {
+		return fmt.Errorf("invalid signature of prover contract %s", contract.Hex())
+	}
+
+	return nil
+}
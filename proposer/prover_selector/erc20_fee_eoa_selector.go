@@ -0,0 +1,59 @@
+package selector
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	pricefeed "github.com/taikoxyz/taiko-client/pkg/price_feed"
+)
+
+// ERC20FeeEOASelector is a prover selector implementation which uses an ERC-20 token as prover fee and
+// all provers selected must be EOA accounts or EIP-1271 contracts, the tier fees are configured in wei,
+// and converted to the token's units through the price feed.
+type ERC20FeeEOASelector struct {
+	*ETHFeeEOASelector
+	feeToken  common.Address
+	priceFeed pricefeed.PriceFeed
+}
+
+// NewERC20FeeEOASelector creates a new ERC20FeeEOASelector instance, which reuses the prover endpoints,
+// reputation and tier fee price bump configurations of the given ETHFeeEOASelector.
+func NewERC20FeeEOASelector(
+	selector *ETHFeeEOASelector,
+	feeToken common.Address,
+	priceFeed pricefeed.PriceFeed,
+) (*ERC20FeeEOASelector, error) {
+	if feeToken == (common.Address{}) {
+		return nil, errors.New("empty fee token")
+	}
+	if priceFeed == nil {
+		return nil, errors.New("empty fee token price feed")
+	}
+
+	return &ERC20FeeEOASelector{selector, feeToken, priceFeed}, nil
+}
+
+// FeeToken returns the ERC-20 token to pay the prover fees.
+func (s *ERC20FeeEOASelector) FeeToken() common.Address { return s.feeToken }
+
+// AssignProver tries to pick a prover through the registered prover endpoints, with the prover fees
+// paid in the ERC-20 token, the returned fee is the max prover fee in the token's units.
+func (s *ERC20FeeEOASelector) AssignProver(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	tokenTierFees := make([]encoding.TierFee, len(tierFees))
+	for i, tierFee := range tierFees {
+		fee, err := pricefeed.WeiToToken(ctx, s.priceFeed, s.feeToken, tierFee.Fee)
+		if err != nil {
+			return nil, common.Address{}, nil, err
+		}
+		tokenTierFees[i] = encoding.TierFee{Tier: tierFee.Tier, Fee: fee}
+	}
+
+	return s.assignProverWithFeeToken(ctx, s.feeToken, tokenTierFees, txListHash)
+}
@@ -0,0 +1,22 @@
+package selector
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	pricefeed "github.com/taikoxyz/taiko-client/pkg/price_feed"
+)
+
+func TestNewERC20FeeEOASelectorErr(t *testing.T) {
+	feed := pricefeed.NewStaticPriceFeed(nil)
+
+	_, err := NewERC20FeeEOASelector(&ETHFeeEOASelector{}, common.Address{}, feed)
+	require.ErrorContains(t, err, "empty fee token")
+	_, err = NewERC20FeeEOASelector(&ETHFeeEOASelector{}, common.BigToAddress(common.Big1), nil)
+	require.ErrorContains(t, err, "empty fee token price feed")
+
+	s, err := NewERC20FeeEOASelector(&ETHFeeEOASelector{}, common.BigToAddress(common.Big1), feed)
+	require.Nil(t, err)
+	require.Equal(t, common.BigToAddress(common.Big1), s.FeeToken())
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"math/rand"
 	"net/url"
 	"time"
 
@@ -28,7 +27,7 @@ var (
 )
 
 // ETHFeeEOASelector is a prover selector implementation which use ETHs as prover fee and
-// all provers selected must be EOA accounts.
+// all provers selected must be EOA accounts, or smart contracts which support EIP-1271 signatures.
 type ETHFeeEOASelector struct {
 	protocolConfigs               *bindings.TaikoDataConfig
 	rpc                           *rpc.Client
@@ -37,12 +36,16 @@ type ETHFeeEOASelector struct {
 	tiersFee                      []encoding.TierFee
 	tierFeePriceBump              *big.Int
 	proverEndpoints               []*url.URL
+	reputation                    *Reputation
+	discovery                     *Discovery
 	maxTierFeePriceBumpIterations uint64
 	proposalExpiry                time.Duration
 	requestTimeout                time.Duration
+	auctionDeadline               time.Duration
 }
 
-// NewETHFeeEOASelector creates a new ETHFeeEOASelector instance.
+// NewETHFeeEOASelector creates a new ETHFeeEOASelector instance, a non-zero auctionDeadline enables the auction
+// mode, which sends the assignment requests to all prover endpoints at once.
 func NewETHFeeEOASelector(
 	protocolConfigs *bindings.TaikoDataConfig,
 	rpc *rpc.Client,
@@ -51,11 +54,14 @@ func NewETHFeeEOASelector(
 	tiersFee []encoding.TierFee,
 	tierFeePriceBump *big.Int,
 	proverEndpoints []*url.URL,
+	reputation *Reputation,
+	discovery *Discovery,
 	maxTierFeePriceBumpIterations uint64,
 	proposalExpiry time.Duration,
 	requestTimeout time.Duration,
+	auctionDeadline time.Duration,
 ) (*ETHFeeEOASelector, error) {
-	if len(proverEndpoints) == 0 {
+	if len(proverEndpoints) == 0 && discovery == nil {
 		return nil, errEmptyProverEndpoints
 	}
 
@@ -65,6 +71,13 @@ func NewETHFeeEOASelector(
 		}
 	}
 
+	if reputation == nil {
+		var err error
+		if reputation, err = NewReputation(&ReputationConfig{}); err != nil {
+			return nil, err
+		}
+	}
+
 	return &ETHFeeEOASelector{
 		protocolConfigs,
 		rpc,
@@ -73,20 +86,58 @@ func NewETHFeeEOASelector(
 		tiersFee,
 		tierFeePriceBump,
 		proverEndpoints,
+		reputation,
+		discovery,
 		maxTierFeePriceBumpIterations,
 		proposalExpiry,
 		requestTimeout,
+		auctionDeadline,
 	}, nil
 }
 
-// ProverEndpoints returns all registered prover endpoints.
-func (s *ETHFeeEOASelector) ProverEndpoints() []*url.URL { return s.proverEndpoints }
+// ProverEndpoints returns all registered prover endpoints, including the discovered ones.
+func (s *ETHFeeEOASelector) ProverEndpoints() []*url.URL {
+	if s.discovery == nil {
+		return s.proverEndpoints
+	}
+
+	var (
+		endpoints = make([]*url.URL, len(s.proverEndpoints))
+		seen      = make(map[string]bool)
+	)
+	copy(endpoints, s.proverEndpoints)
+	for _, endpoint := range s.proverEndpoints {
+		seen[endpoint.String()] = true
+	}
+	for _, endpoint := range s.discovery.Endpoints() {
+		if !seen[endpoint.String()] {
+			endpoints = append(endpoints, endpoint)
+			seen[endpoint.String()] = true
+		}
+	}
+
+	return endpoints
+}
+
+// FeeToken returns the zero address, since the prover fees are paid in ETH.
+func (s *ETHFeeEOASelector) FeeToken() common.Address { return rpc.ZeroAddress }
 
 // AssignProver tries to pick a prover through the registered prover endpoints.
 func (s *ETHFeeEOASelector) AssignProver(
 	ctx context.Context,
 	tierFees []encoding.TierFee,
 	txListHash common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	return s.assignProverWithFeeToken(ctx, rpc.ZeroAddress, tierFees, txListHash)
+}
+
+// assignProverWithFeeToken tries to pick a prover through the registered prover endpoints, with the prover
+// fees paid in the given fee token, both the given tier fees and the returned fee are in the fee token's units.
+func (s *ETHFeeEOASelector) assignProverWithFeeToken(
+	ctx context.Context,
+	feeToken common.Address,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
 ) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
 	guardianProverAddress, err := s.rpc.TaikoL1.Resolve0(
 		&bind.CallOpts{Context: ctx},
@@ -98,10 +149,8 @@ func (s *ETHFeeEOASelector) AssignProver(
 	}
 
 	var (
-		expiry       = uint64(time.Now().Add(s.proposalExpiry).Unix())
-		fees         = make([]encoding.TierFee, len(tierFees))
-		big100       = new(big.Int).SetUint64(uint64(100))
-		maxProverFee = common.Big0
+		expiry = uint64(time.Now().Add(s.proposalExpiry).Unix())
+		fees   = make([]encoding.TierFee, len(tierFees))
 	)
 	copy(fees, tierFees)
 
@@ -110,24 +159,30 @@ func (s *ETHFeeEOASelector) AssignProver(
 	// If we do not find a prover, we can increase the fee up to a point, or give up.
 	for i := 0; i < int(s.maxTierFeePriceBumpIterations); i++ {
 		// Bump tier fee on each failed loop
-		cumulativeBumpPercent := new(big.Int).Mul(s.tierFeePriceBump, new(big.Int).SetUint64(uint64(i)))
-		for idx := range fees {
-			if i > 0 {
-				fee := new(big.Int).Mul(fees[idx].Fee, cumulativeBumpPercent)
-				fees[idx].Fee = fees[idx].Fee.Add(fees[idx].Fee, fee.Div(fee, big100))
+		if i > 0 {
+			for idx := range fees {
+				fees[idx].Fee = bumpTierFee(fees[idx].Fee, s.tierFeePriceBump, i)
 			}
-			if fees[idx].Fee.Cmp(maxProverFee) > 0 {
-				maxProverFee = fees[idx].Fee
+		}
+
+		if s.auctionDeadline != 0 {
+			if b := s.auction(ctx, feeToken, expiry, fees, txListHash, guardianProverAddress); b != nil {
+				s.reputation.OnProverAssigned(b.endpoint, b.prover, txListHash, b.assignment.Expiry)
+				return b.assignment, b.prover, maxTierFee(b.assignment.TierFees), nil
 			}
+			continue
 		}
 
-		for _, endpoint := range s.shuffleProverEndpoints() {
+		for _, endpoint := range s.reputation.Sort(s.ProverEndpoints()) {
+			requestedAt := time.Now()
 			encodedAssignment, proverAddress, err := assignProver(
 				ctx,
 				s.protocolConfigs.ChainId,
 				endpoint,
+				feeToken,
 				expiry,
-				tierFees,
+				fees,
+				nil,
 				s.taikoL1Address,
 				s.assignmentHookAddress,
 				txListHash,
@@ -136,8 +191,15 @@ func (s *ETHFeeEOASelector) AssignProver(
 			)
 			if err != nil {
 				log.Warn("Failed to assign prover", "endpoint", endpoint, "error", err)
+				s.reputation.OnAssignmentFailed(endpoint)
+				continue
+			}
+			if err := s.verifyAssignment(ctx, encodedAssignment, proverAddress, txListHash); err != nil {
+				log.Warn("Invalid prover assignment", "endpoint", endpoint, "prover", proverAddress, "error", err)
+				s.reputation.OnAssignmentFailed(endpoint)
 				continue
 			}
+			s.reputation.OnAssignmentAccepted(endpoint, time.Since(requestedAt), s.requestTimeout)
 
 			ok, err := rpc.CheckProverBalance(
 				ctx,
@@ -154,32 +216,55 @@ func (s *ETHFeeEOASelector) AssignProver(
 				continue
 			}
 
-			return encodedAssignment, proverAddress, maxProverFee, nil
+			s.reputation.OnProverAssigned(endpoint, proverAddress, txListHash, encodedAssignment.Expiry)
+			return encodedAssignment, proverAddress, maxTierFee(encodedAssignment.TierFees), nil
 		}
 	}
 
 	return nil, common.Address{}, nil, errUnableToFindProver
 }
 
-// shuffleProverEndpoints shuffles the current selector's prover endpoints.
-func (s *ETHFeeEOASelector) shuffleProverEndpoints() []*url.URL {
-	// Clone the slice to avoid modifying the original proverEndpoints
-	shuffledEndpoints := make([]*url.URL, len(s.proverEndpoints))
-	copy(shuffledEndpoints, s.proverEndpoints)
+// bumpTierFee returns the given tier fee bumped by the cumulative price bump percentage of the given
+// iteration, the given tier fee is not modified.
+func bumpTierFee(fee *big.Int, tierFeePriceBump *big.Int, iteration int) *big.Int {
+	bump := new(big.Int).Mul(fee, new(big.Int).Mul(tierFeePriceBump, big.NewInt(int64(iteration))))
+	return new(big.Int).Add(fee, bump.Div(bump, big.NewInt(100)))
+}
+
+// maxTierFee returns the highest fee of the given tier fees.
+func maxTierFee(tierFees []encoding.TierFee) *big.Int {
+	maxFee := common.Big0
+	for _, tierFee := range tierFees {
+		if tierFee.Fee.Cmp(maxFee) > 0 {
+			maxFee = tierFee.Fee
+		}
+	}
+
+	return maxFee
+}
+
+// MaxBumpedTierFee returns the given tier fee after all price bumps, which is the highest fee a prover
+// selector may agree on for it.
+func MaxBumpedTierFee(fee *big.Int, tierFeePriceBump *big.Int, maxTierFeePriceBumps uint64) *big.Int {
+	bumped := new(big.Int).Set(fee)
+	for i := 1; i < int(maxTierFeePriceBumps); i++ {
+		bumped = bumpTierFee(bumped, tierFeePriceBump, i)
+	}
 
-	rand.Shuffle(len(shuffledEndpoints), func(i, j int) {
-		shuffledEndpoints[i], shuffledEndpoints[j] = shuffledEndpoints[j], shuffledEndpoints[i]
-	})
-	return shuffledEndpoints
+	return bumped
 }
 
-// assignProver tries to assign a proof generation task to the given prover by HTTP API.
+// assignProver tries to assign a proof generation task to the given prover by HTTP API, the returned
+// assignment should be verified by verifyAssignment before proposing. The optional quote signature is
+// the signature of an unexpired quote of the prover, whose quoted tier fees will be honored.
 func assignProver(
 	ctx context.Context,
 	chainID uint64,
 	endpoint *url.URL,
+	feeToken common.Address,
 	expiry uint64,
 	tierFees []encoding.TierFee,
+	quoteSignature []byte,
 	taikoL1Address common.Address,
 	assignmentHookAddress common.Address,
 	txListHash common.Hash,
@@ -189,6 +274,7 @@ func assignProver(
 	log.Info(
 		"Attempting to assign prover",
 		"endpoint", endpoint,
+		"feeToken", feeToken,
 		"expiry", expiry,
 		"txListHash", txListHash,
 	)
@@ -197,10 +283,11 @@ func assignProver(
 	var (
 		client  = resty.New()
 		reqBody = &server.CreateAssignmentRequestBody{
-			FeeToken:   rpc.ZeroAddress,
-			TierFees:   tierFees,
-			Expiry:     expiry,
-			TxListHash: txListHash,
+			FeeToken:       feeToken,
+			TierFees:       tierFees,
+			Expiry:         expiry,
+			TxListHash:     txListHash,
+			QuoteSignature: quoteSignature,
 		}
 		result = server.ProposeBlockResponse{}
 	)
@@ -226,34 +313,10 @@ func assignProver(
 		return nil, common.Address{}, fmt.Errorf("unsuccessful response %d", resp.StatusCode())
 	}
 
-	// Ensure prover in response is the same as the one recovered
-	// from the signature
-	payload, err := encoding.EncodeProverAssignmentPayload(
-		chainID,
-		taikoL1Address,
-		assignmentHookAddress,
-		txListHash,
-		common.Address{},
-		expiry,
-		result.MaxBlockID,
-		result.MaxProposedIn,
-		tierFees,
-	)
-	if err != nil {
-		return nil, common.Address{}, err
-	}
-
-	pubKey, err := crypto.SigToPub(crypto.Keccak256Hash(payload).Bytes(), result.SignedPayload)
-	if err != nil {
-		return nil, common.Address{}, err
-	}
-
-	if crypto.PubkeyToAddress(*pubKey).Hex() != result.Prover.Hex() {
-		return nil, common.Address{}, fmt.Errorf(
-			"assigned prover signature did not recover to provided prover address %s != %s",
-			crypto.PubkeyToAddress(*pubKey).Hex(),
-			result.Prover.Hex(),
-		)
+	// Convert signature to one solidity can recover by adding 27 to 65th byte, the signatures of
+	// the smart contract provers may be in other formats, which are kept as they are.
+	if len(result.SignedPayload) == crypto.SignatureLength && result.SignedPayload[64] < 27 {
+		result.SignedPayload[64] = uint8(uint(result.SignedPayload[64])) + 27
 	}
 
 	log.Info(
@@ -265,11 +328,8 @@ func assignProver(
 		"expiry", expiry,
 	)
 
-	// Convert signature to one solidity can recover by adding 27 to 65th byte
-	result.SignedPayload[64] = uint8(uint(result.SignedPayload[64])) + 27
-
 	return &encoding.ProverAssignment{
-		FeeToken:      common.Address{},
+		FeeToken:      feeToken,
 		TierFees:      tierFees,
 		Expiry:        reqBody.Expiry,
 		MaxBlockId:    result.MaxBlockID,
@@ -278,3 +338,35 @@ func assignProver(
 		Signature:     result.SignedPayload,
 	}, result.Prover, nil
 }
+
+// quoteProver fetches the current tier fees quoted by the given prover for the given fee token by HTTP API.
+func quoteProver(
+	ctx context.Context,
+	endpoint *url.URL,
+	feeToken common.Address,
+	timeout time.Duration,
+) (*server.QuoteResponse, error) {
+	requestURL, err := url.JoinPath(endpoint.String(), "/quote")
+	if err != nil {
+		return nil, err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result := new(server.QuoteResponse)
+	resp, err := resty.New().R().
+		SetContext(ctxTimeout).
+		SetHeader("Accept", "application/json").
+		SetQueryParam("feeToken", feeToken.Hex()).
+		SetResult(result).
+		Get(requestURL)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("unsuccessful response %d", resp.StatusCode())
+	}
+
+	return result, nil
+}
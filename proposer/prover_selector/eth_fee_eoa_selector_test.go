@@ -2,6 +2,7 @@ package selector
 
 import (
 	"context"
+	"math/big"
 	"net/url"
 	"os"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/testutils"
@@ -38,9 +40,12 @@ func (s *ProverSelectorTestSuite) SetupTest() {
 		[]encoding.TierFee{},
 		common.Big2,
 		[]*url.URL{s.ProverEndpoints[0]},
+		nil,
+		nil,
 		32,
 		1*time.Minute,
 		1*time.Minute,
+		0,
 	)
 	s.Nil(err)
 }
@@ -61,6 +66,30 @@ func (s *ProverSelectorTestSuite) TestProverAssignProver() {
 	s.Nil(err)
 }
 
+func (s *ProverSelectorTestSuite) TestProverAssignProverAuction() {
+	s.s.auctionDeadline = 10 * time.Second
+	defer func() { s.s.auctionDeadline = 0 }()
+
+	sig, proverAddress, fee, err := s.s.AssignProver(context.Background(), []encoding.TierFee{
+		{Tier: encoding.TierOptimisticID, Fee: common.Big256},
+		{Tier: encoding.TierSgxID, Fee: common.Big256},
+		{Tier: encoding.TierPseZkevmID, Fee: common.Big256},
+		{Tier: encoding.TierSgxAndPseZkevmID, Fee: common.Big256},
+	}, testutils.RandomHash())
+	s.Nil(err)
+	s.NotEmpty(sig)
+	s.Equal(s.proverAddress, proverAddress)
+	s.True(fee.Cmp(common.Big0) > 0)
+}
+
+func TestMaxBumpedTierFee(t *testing.T) {
+	fee := big.NewInt(100)
+
+	require.Equal(t, uint64(100), MaxBumpedTierFee(fee, big.NewInt(10), 1).Uint64())
+	require.Equal(t, uint64(132), MaxBumpedTierFee(fee, big.NewInt(10), 3).Uint64())
+	require.Equal(t, uint64(100), fee.Uint64())
+}
+
 func TestProverSelectorTestSuite(t *testing.T) {
 	suite.Run(t, new(ProverSelectorTestSuite))
 }
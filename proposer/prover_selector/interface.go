@@ -9,11 +9,16 @@ import (
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 )
 
+// ProverSelector is responsible for picking a prover for the blocks to propose.
 type ProverSelector interface {
+	// AssignProver picks a prover for the given transactions list, the given tier fees are in wei, and the
+	// returned fee is in the fee token's units, i.e. in wei when the prover fees are paid in ETH.
 	AssignProver(
 		ctx context.Context,
 		tierFees []encoding.TierFee,
 		txListHash common.Hash,
 	) (assignment *encoding.ProverAssignment, assignedProver common.Address, fee *big.Int, err error)
 	ProverEndpoints() []*url.URL
+	// FeeToken returns the token to pay the prover fees, the zero address means ETH.
+	FeeToken() common.Address
 }
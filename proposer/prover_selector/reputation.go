@@ -0,0 +1,381 @@
+package selector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// Score changes of the prover reputation events, a prover endpoint's score is decayed by scoreDecay
+// before each change, so the recent events weigh more than the old ones.
+var (
+	scoreDecay            = 0.9
+	maxScore              = 20.0
+	minScore              = -50.0
+	assignmentAcceptScore = 1.0
+	httpFailureScore      = -1.0
+	blockProvedScore      = 2.0
+	provingMissedScore    = -5.0
+)
+
+// ReputationConfig contains all configurations for the prover endpoints reputation.
+type ReputationConfig struct {
+	// Path of the file to persist the reputation, empty means keeping it in memory only.
+	StorePath string
+	// Number of consecutive HTTP failures before an endpoint is cooled down, zero disables the cool-down.
+	CooldownThreshold uint64
+	CooldownDuration  time.Duration
+	// Score under which an endpoint is blacklisted, zero BlacklistDuration disables the blacklist.
+	BlacklistScore    float64
+	BlacklistDuration time.Duration
+}
+
+// endpointReputation is the reputation record of a prover endpoint.
+type endpointReputation struct {
+	Score               float64        `json:"score"`
+	Prover              common.Address `json:"prover"`
+	Accepted            uint64         `json:"accepted"`
+	HTTPFailures        uint64         `json:"httpFailures"`
+	ConsecutiveFailures uint64         `json:"consecutiveFailures"`
+	Proved              uint64         `json:"proved"`
+	Missed              uint64         `json:"missed"`
+	CooldownUntil       time.Time      `json:"cooldownUntil"`
+	BlacklistedUntil    time.Time      `json:"blacklistedUntil"`
+}
+
+// assignedEndpoint is an endpoint whose verified prover assignment has been picked for proposing.
+type assignedEndpoint struct {
+	endpoint string
+	prover   common.Address
+	expiry   time.Time
+	// Whether the endpoint has been rewarded for the assigned prover's proof.
+	rewarded bool
+}
+
+// Reputation keeps a persistent per-endpoint score of the prover endpoints, based on the assignment
+// acceptance latency, the HTTP failures, and the on-chain proving outcomes of the blocks assigned
+// through each endpoint.
+type Reputation struct {
+	cfg       *ReputationConfig
+	endpoints map[string]*endpointReputation
+	// Picked prover assignments by their txList hashes, whose blocks have not been proposed yet.
+	pendingAssignments map[common.Hash][]*assignedEndpoint
+	// Blocks assigned through the endpoints, which have not been verified yet.
+	assignedBlocks map[uint64]*assignedEndpoint
+	mutex          sync.Mutex
+}
+
+// NewReputation creates a new Reputation instance, and loads the persisted reputation if there is any.
+func NewReputation(cfg *ReputationConfig) (*Reputation, error) {
+	r := &Reputation{
+		cfg:                cfg,
+		endpoints:          make(map[string]*endpointReputation),
+		pendingAssignments: make(map[common.Hash][]*assignedEndpoint),
+		assignedBlocks:     make(map[uint64]*assignedEndpoint),
+	}
+
+	if cfg.StorePath == "" {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(cfg.StorePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return r, nil
+		}
+		return nil, fmt.Errorf("failed to read prover reputation file: %w", err)
+	}
+	if err := json.Unmarshal(data, &r.endpoints); err != nil {
+		return nil, fmt.Errorf("failed to decode prover reputation file: %w", err)
+	}
+
+	return r, nil
+}
+
+// Sort returns the available endpoints in descending score order, the endpoints with the same score are
+// shuffled, the cooling down and blacklisted endpoints are skipped.
+func (r *Reputation) Sort(endpoints []*url.URL) []*url.URL {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var (
+		now       = time.Now()
+		available = make([]*url.URL, 0, len(endpoints))
+		scores    = make(map[string]float64, len(endpoints))
+	)
+	for _, endpoint := range endpoints {
+		reputation := r.endpointReputation(endpoint.String())
+		if now.Before(reputation.CooldownUntil) || now.Before(reputation.BlacklistedUntil) {
+			log.Debug("Skip unavailable prover endpoint", "endpoint", endpoint, "score", reputation.Score)
+			continue
+		}
+		// Give the endpoint a fresh start once its blacklist period is over.
+		if !reputation.BlacklistedUntil.IsZero() {
+			reputation.Score = 0
+			reputation.BlacklistedUntil = time.Time{}
+		}
+
+		available = append(available, endpoint)
+		scores[endpoint.String()] = reputation.Score
+	}
+
+	rand.Shuffle(len(available), func(i, j int) {
+		available[i], available[j] = available[j], available[i]
+	})
+	sort.SliceStable(available, func(i, j int) bool {
+		return scores[available[i].String()] > scores[available[j].String()]
+	})
+
+	return available
+}
+
+// Score returns the current score of the given endpoint.
+func (r *Reputation) Score(endpoint *url.URL) float64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.endpointReputation(endpoint.String()).Score
+}
+
+// OnAssignmentAccepted updates the endpoint's reputation after it accepted an assignment with a valid
+// signature, the faster the endpoint responded, the higher the score it gets.
+func (r *Reputation) OnAssignmentAccepted(endpoint *url.URL, latency time.Duration, timeout time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	reputation := r.endpointReputation(endpoint.String())
+	reputation.Accepted++
+	reputation.ConsecutiveFailures = 0
+
+	score := assignmentAcceptScore
+	if timeout > 0 && latency < timeout {
+		score -= float64(latency) / float64(timeout)
+	}
+	r.updateScore(endpoint.String(), reputation, score)
+}
+
+// OnAssignmentFailed updates the endpoint's reputation after a failed assignment request.
+func (r *Reputation) OnAssignmentFailed(endpoint *url.URL) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	reputation := r.endpointReputation(endpoint.String())
+	reputation.HTTPFailures++
+	reputation.ConsecutiveFailures++
+
+	if r.cfg.CooldownThreshold != 0 && reputation.ConsecutiveFailures >= r.cfg.CooldownThreshold {
+		log.Warn(
+			"Cool down prover endpoint",
+			"endpoint", endpoint,
+			"consecutiveFailures", reputation.ConsecutiveFailures,
+			"duration", r.cfg.CooldownDuration,
+		)
+		reputation.CooldownUntil = time.Now().Add(r.cfg.CooldownDuration)
+		reputation.ConsecutiveFailures = 0
+	}
+	r.updateScore(endpoint.String(), reputation, httpFailureScore)
+}
+
+// OnProverAssigned binds the prover address to the endpoint, after the endpoint's signed prover assignment
+// for the given txList hash has been verified and picked for proposing, and records the assignment, so
+// that the proving outcomes of its block are attributed to this endpoint only.
+func (r *Reputation) OnProverAssigned(
+	endpoint *url.URL,
+	prover common.Address,
+	txListHash common.Hash,
+	expiry uint64,
+) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.endpointReputation(endpoint.String()).Prover = prover
+
+	// Forget the assignments which expired before their blocks got proposed.
+	now := time.Now()
+	for hash, assignments := range r.pendingAssignments {
+		unexpired := assignments[:0]
+		for _, assignment := range assignments {
+			if now.Before(assignment.expiry) {
+				unexpired = append(unexpired, assignment)
+			}
+		}
+		if len(unexpired) == 0 {
+			delete(r.pendingAssignments, hash)
+		} else {
+			r.pendingAssignments[hash] = unexpired
+		}
+	}
+
+	r.pendingAssignments[txListHash] = append(r.pendingAssignments[txListHash], &assignedEndpoint{
+		endpoint: endpoint.String(),
+		prover:   prover,
+		expiry:   time.Unix(int64(expiry), 0),
+	})
+}
+
+// OnBlockProposed records the endpoint which assigned the given proposed block, if the block is proposed
+// with a prover assignment picked by this proposer.
+func (r *Reputation) OnBlockProposed(e *bindings.TaikoL1ClientBlockProposed) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	txListHash := common.Hash(e.Meta.BlobHash)
+	assignments := r.pendingAssignments[txListHash]
+	for i, assignment := range assignments {
+		if assignment.prover != e.AssignedProver {
+			continue
+		}
+
+		r.assignedBlocks[e.BlockId.Uint64()] = assignment
+		if assignments = append(assignments[:i], assignments[i+1:]...); len(assignments) == 0 {
+			delete(r.pendingAssignments, txListHash)
+		} else {
+			r.pendingAssignments[txListHash] = assignments
+		}
+		return
+	}
+}
+
+// OnTransitionProved rewards the endpoint of the assigned prover, if the given transition is proved by it.
+func (r *Reputation) OnTransitionProved(e *bindings.TaikoL1ClientTransitionProved) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	assigned, ok := r.assignedBlocks[e.BlockId.Uint64()]
+	if !ok || assigned.rewarded || assigned.prover != e.Prover {
+		return
+	}
+	assigned.rewarded = true
+
+	r.onProvingOutcome(assigned.endpoint, true)
+}
+
+// OnBlockVerified updates the reputation of the endpoint which assigned the verified block, an assigned
+// prover who did not provide the verified transition missed its proving window and lost its liveness bond.
+func (r *Reputation) OnBlockVerified(e *bindings.TaikoL1ClientBlockVerified) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	assigned, ok := r.assignedBlocks[e.BlockId.Uint64()]
+	if !ok {
+		return
+	}
+	delete(r.assignedBlocks, e.BlockId.Uint64())
+
+	if e.AssignedProver != e.Prover {
+		r.onProvingOutcome(assigned.endpoint, false)
+	} else if !assigned.rewarded {
+		r.onProvingOutcome(assigned.endpoint, true)
+	}
+}
+
+// Track subscribes the protocol events to update the provers' reputation, until the given context is done.
+func (r *Reputation) Track(ctx context.Context, cli *rpc.Client) {
+	var (
+		blockProposedCh     = make(chan *bindings.TaikoL1ClientBlockProposed, 10)
+		transitionProvedCh  = make(chan *bindings.TaikoL1ClientTransitionProved, 10)
+		blockVerifiedCh     = make(chan *bindings.TaikoL1ClientBlockVerified, 10)
+		blockProposedSub    = rpc.SubscribeBlockProposed(cli.TaikoL1, blockProposedCh)
+		transitionProvedSub = rpc.SubscribeTransitionProved(cli.TaikoL1, transitionProvedCh)
+		blockVerifiedSub    = rpc.SubscribeBlockVerified(cli.TaikoL1, blockVerifiedCh)
+	)
+	defer func() {
+		blockProposedSub.Unsubscribe()
+		transitionProvedSub.Unsubscribe()
+		blockVerifiedSub.Unsubscribe()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-blockProposedCh:
+			r.OnBlockProposed(e)
+		case e := <-transitionProvedCh:
+			r.OnTransitionProved(e)
+		case e := <-blockVerifiedCh:
+			r.OnBlockVerified(e)
+		}
+	}
+}
+
+// onProvingOutcome updates the given endpoint's reputation with an on-chain proving outcome.
+func (r *Reputation) onProvingOutcome(endpoint string, proved bool) {
+	reputation := r.endpointReputation(endpoint)
+	score := blockProvedScore
+	if proved {
+		reputation.Proved++
+	} else {
+		score = provingMissedScore
+		reputation.Missed++
+	}
+	r.updateScore(endpoint, reputation, score)
+}
+
+// endpointReputation returns the reputation record of the given endpoint, creates a new one if not exists.
+func (r *Reputation) endpointReputation(endpoint string) *endpointReputation {
+	reputation, ok := r.endpoints[endpoint]
+	if !ok {
+		reputation = new(endpointReputation)
+		r.endpoints[endpoint] = reputation
+	}
+	return reputation
+}
+
+// updateScore applies the given score change to the endpoint's reputation, blacklists the endpoint if its
+// score drops under the threshold, and then persists the reputation.
+func (r *Reputation) updateScore(endpoint string, reputation *endpointReputation, change float64) {
+	reputation.Score = reputation.Score*scoreDecay + change
+	if reputation.Score > maxScore {
+		reputation.Score = maxScore
+	}
+	if reputation.Score < minScore {
+		reputation.Score = minScore
+	}
+
+	if r.cfg.BlacklistDuration != 0 && reputation.Score < r.cfg.BlacklistScore {
+		log.Warn(
+			"Blacklist prover endpoint",
+			"endpoint", endpoint,
+			"score", reputation.Score,
+			"duration", r.cfg.BlacklistDuration,
+		)
+		reputation.BlacklistedUntil = time.Now().Add(r.cfg.BlacklistDuration)
+	}
+
+	if err := r.save(); err != nil {
+		log.Warn("Failed to persist prover reputation", "error", err)
+	}
+}
+
+// save persists the reputation to the store file, if there is one.
+func (r *Reputation) save() error {
+	if r.cfg.StorePath == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(r.endpoints)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, to avoid corrupting the store file.
+	tmpPath := r.cfg.StorePath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, r.cfg.StorePath)
+}
@@ -0,0 +1,141 @@
+package selector
+
+import (
+	"math/big"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+var (
+	testEndpointA, _ = url.Parse("http://prover-a:9876")
+	testEndpointB, _ = url.Parse("http://prover-b:9876")
+	testProverA      = common.BigToAddress(common.Big1)
+	testProverB      = common.BigToAddress(common.Big2)
+)
+
+func TestReputationSort(t *testing.T) {
+	r, err := NewReputation(&ReputationConfig{})
+	require.Nil(t, err)
+
+	r.OnAssignmentAccepted(testEndpointA, time.Second, time.Minute)
+	r.OnAssignmentAccepted(testEndpointB, 30*time.Second, time.Minute)
+	require.Greater(t, r.Score(testEndpointA), r.Score(testEndpointB))
+	require.Equal(t, []*url.URL{testEndpointA, testEndpointB}, r.Sort([]*url.URL{testEndpointB, testEndpointA}))
+
+	r.OnAssignmentFailed(testEndpointA)
+	require.Equal(t, []*url.URL{testEndpointB, testEndpointA}, r.Sort([]*url.URL{testEndpointA, testEndpointB}))
+}
+
+func TestReputationCooldown(t *testing.T) {
+	r, err := NewReputation(&ReputationConfig{CooldownThreshold: 2, CooldownDuration: time.Hour})
+	require.Nil(t, err)
+
+	r.OnAssignmentFailed(testEndpointA)
+	require.Len(t, r.Sort([]*url.URL{testEndpointA, testEndpointB}), 2)
+
+	r.OnAssignmentFailed(testEndpointA)
+	require.Equal(t, []*url.URL{testEndpointB}, r.Sort([]*url.URL{testEndpointA, testEndpointB}))
+}
+
+func TestReputationProvingOutcomes(t *testing.T) {
+	r, err := NewReputation(&ReputationConfig{BlacklistScore: -4, BlacklistDuration: time.Hour})
+	require.Nil(t, err)
+
+	var (
+		expiry      = uint64(time.Now().Add(time.Minute).Unix())
+		txListHashA = common.BigToHash(common.Big1)
+		txListHashB = common.BigToHash(common.Big2)
+	)
+	r.OnAssignmentAccepted(testEndpointA, 0, time.Minute)
+	r.OnAssignmentAccepted(testEndpointB, 0, time.Minute)
+	r.OnProverAssigned(testEndpointA, testProverA, txListHashA, expiry)
+	r.OnProverAssigned(testEndpointB, testProverB, txListHashB, expiry)
+
+	// Proved by the assigned prover.
+	r.OnBlockProposed(&bindings.TaikoL1ClientBlockProposed{
+		BlockId:        common.Big1,
+		AssignedProver: testProverA,
+		Meta:           bindings.TaikoDataBlockMetadata{BlobHash: txListHashA},
+	})
+	r.OnTransitionProved(&bindings.TaikoL1ClientTransitionProved{BlockId: common.Big1, Prover: testProverA})
+	r.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{
+		BlockId:        common.Big1,
+		AssignedProver: testProverA,
+		Prover:         testProverA,
+	})
+	require.Equal(t, 1*scoreDecay+blockProvedScore, r.Score(testEndpointA))
+
+	// Proving window missed by the assigned prover.
+	r.OnBlockProposed(&bindings.TaikoL1ClientBlockProposed{
+		BlockId:        common.Big2,
+		AssignedProver: testProverB,
+		Meta:           bindings.TaikoDataBlockMetadata{BlobHash: txListHashB},
+	})
+	r.OnTransitionProved(&bindings.TaikoL1ClientTransitionProved{BlockId: common.Big2, Prover: testProverA})
+	r.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{
+		BlockId:        common.Big2,
+		AssignedProver: testProverB,
+		Prover:         testProverA,
+	})
+	require.Equal(t, 1*scoreDecay+provingMissedScore, r.Score(testEndpointB))
+
+	// Blacklisted.
+	require.Equal(t, []*url.URL{testEndpointA}, r.Sort([]*url.URL{testEndpointA, testEndpointB}))
+
+	// Blocks not assigned through the endpoints are ignored.
+	r.OnBlockProposed(&bindings.TaikoL1ClientBlockProposed{BlockId: big.NewInt(3), AssignedProver: testProverA})
+	require.Empty(t, r.assignedBlocks)
+	require.Empty(t, r.pendingAssignments)
+}
+
+func TestReputationAssignedEndpointOnly(t *testing.T) {
+	r, err := NewReputation(&ReputationConfig{})
+	require.Nil(t, err)
+
+	// Endpoint B claims the prover address of endpoint A, without any of its assignments being picked.
+	txListHash := common.BigToHash(common.Big1)
+	r.OnAssignmentAccepted(testEndpointA, 0, time.Minute)
+	r.OnAssignmentAccepted(testEndpointB, 0, time.Minute)
+	r.OnProverAssigned(testEndpointA, testProverA, txListHash, uint64(time.Now().Add(time.Minute).Unix()))
+
+	r.OnBlockProposed(&bindings.TaikoL1ClientBlockProposed{
+		BlockId:        common.Big1,
+		AssignedProver: testProverA,
+		Meta:           bindings.TaikoDataBlockMetadata{BlobHash: txListHash},
+	})
+	r.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{
+		BlockId:        common.Big1,
+		AssignedProver: testProverA,
+		Prover:         testProverA,
+	})
+	require.Equal(t, 1*scoreDecay+blockProvedScore, r.Score(testEndpointA))
+	require.Equal(t, 1.0, r.Score(testEndpointB))
+	require.Equal(t, common.Address{}, r.endpoints[testEndpointB.String()].Prover)
+
+	// The expired pending assignments are forgotten.
+	r.OnProverAssigned(testEndpointB, testProverB, txListHash, uint64(time.Now().Add(-time.Minute).Unix()))
+	r.OnProverAssigned(testEndpointA, testProverA, common.BigToHash(common.Big2), uint64(time.Now().Unix())+60)
+	require.Len(t, r.pendingAssignments, 1)
+}
+
+func TestReputationPersistence(t *testing.T) {
+	storePath := filepath.Join(t.TempDir(), "reputation.json")
+
+	r, err := NewReputation(&ReputationConfig{StorePath: storePath})
+	require.Nil(t, err)
+	r.OnAssignmentAccepted(testEndpointA, 0, time.Minute)
+	r.OnProverAssigned(testEndpointA, testProverA, common.Hash{}, uint64(time.Now().Add(time.Minute).Unix()))
+	r.OnAssignmentFailed(testEndpointB)
+
+	loaded, err := NewReputation(&ReputationConfig{StorePath: storePath})
+	require.Nil(t, err)
+	require.Equal(t, r.Score(testEndpointA), loaded.Score(testEndpointA))
+	require.Equal(t, r.Score(testEndpointB), loaded.Score(testEndpointB))
+	require.Equal(t, testProverA, loaded.endpoints[testEndpointA.String()].Prover)
+}
@@ -0,0 +1,116 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/metrics"
+)
+
+var errProposeBlockSimulation = errors.New("TaikoL1.proposeBlock simulation failed")
+
+// proposeBlockErrorAction represents how the proposer reacts to a failed TaikoL1.proposeBlock transaction.
+type proposeBlockErrorAction uint8
+
+// All reactions to a failed TaikoL1.proposeBlock transaction.
+const (
+	// Retry sending the transaction with the same prover assignment.
+	proposeBlockRetry proposeBlockErrorAction = iota
+	// Request a new prover assignment, then retry sending the transaction.
+	proposeBlockReassignProver
+	// Give up proposing the transactions list, since retrying will never succeed.
+	proposeBlockAbort
+)
+
+// String implements the fmt.Stringer interface.
+func (a proposeBlockErrorAction) String() string {
+	switch a {
+	case proposeBlockReassignProver:
+		return "reassignProver"
+	case proposeBlockAbort:
+		return "abort"
+	default:
+		return "retry"
+	}
+}
+
+var (
+	// Custom errors caused by the prover assignment, which can be fixed by a new assignment.
+	reassignProverErrors = []string{
+		"HOOK_ASSIGNMENT_EXPIRED",
+		"HOOK_ASSIGNMENT_INSUFFICIENT_FEE",
+		"HOOK_ASSIGNMENT_INVALID_SIG",
+		"HOOK_TIER_NOT_FOUND",
+		"L1_ASSIGNED_PROVER_NOT_ALLOWED",
+		"L1_INVALID_PROVER",
+	}
+	// Custom errors which retrying the same proposal will never fix.
+	abortErrors = []string{
+		"ERC20: insufficient allowance",
+		"L1_BLOB_FOR_DA_DISABLED",
+		"L1_BLOB_NOT_FOUND",
+		"L1_BLOB_NOT_REUSEABLE",
+		"L1_INSUFFICIENT_TOKEN",
+		"L1_INVALID_PARAM",
+		"L1_LIVENESS_BOND_NOT_RECEIVED",
+		"L1_PROPOSER_NOT_EOA",
+		"L1_TXLIST_OFFSET_SIZE",
+		"L1_TXLIST_TOO_LARGE",
+		"L1_UNAUTHORIZED",
+	}
+)
+
+// classifyProposeBlockError decides how to react to the given TaikoL1.proposeBlock error, based on the
+// parsed custom error.
+func classifyProposeBlockError(err error) proposeBlockErrorAction {
+	for _, e := range reassignProverErrors {
+		if strings.Contains(err.Error(), e) {
+			return proposeBlockReassignProver
+		}
+	}
+	for _, e := range abortErrors {
+		if strings.Contains(err.Error(), e) {
+			return proposeBlockAbort
+		}
+	}
+
+	return proposeBlockRetry
+}
+
+// simulateProposeBlock simulates the given TaikoL1.proposeBlock transaction calldata through `eth_call`,
+// with the same sender, value and gas limit as the transaction going to be sent. If the given sidecar
+// is not nil, the call carries its blob versioned hashes, just like the blob transaction going to be sent.
+func (p *Proposer) simulateProposeBlock(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	data []byte,
+	sidecar *types.BlobTxSidecar,
+) error {
+	var err error
+	if sidecar == nil {
+		_, err = p.rpc.L1.CallContract(ctx, ethereum.CallMsg{
+			From:  opts.From,
+			To:    &p.cfg.TaikoL1Address,
+			Gas:   opts.GasLimit,
+			Value: opts.Value,
+			Data:  data,
+		}, nil)
+	} else {
+		err = p.rpc.CallBlobTx(ctx, opts, p.cfg.TaikoL1Address, data, sidecar)
+	}
+	if err != nil {
+		err = encoding.TryParsingCustomError(err)
+		log.Warn("TaikoL1.proposeBlock simulation failed", "error", err)
+		metrics.ProposerSimulationFailedCounter.Inc(1)
+		return fmt.Errorf("%w: %w", errProposeBlockSimulation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package proposer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyProposeBlockError(t *testing.T) {
+	tests := []struct {
+		err    error
+		action proposeBlockErrorAction
+	}{
+		{errors.New("HOOK_ASSIGNMENT_EXPIRED"), proposeBlockReassignProver},
+		{
+			fmt.Errorf("%w: %w", errProposeBlockSimulation, errors.New("HOOK_ASSIGNMENT_INSUFFICIENT_FEE")),
+			proposeBlockReassignProver,
+		},
+		{errors.New("L1_TXLIST_TOO_LARGE"), proposeBlockAbort},
+		{fmt.Errorf("%w: %w", errProposeBlockSimulation, errors.New("L1_BLOB_NOT_FOUND")), proposeBlockAbort},
+		{
+			fmt.Errorf("%w: %w", errProposeBlockSimulation, errors.New("ERC20: insufficient allowance")),
+			proposeBlockAbort,
+		},
+		{errors.New("L1_TOO_MANY_BLOCKS"), proposeBlockRetry},
+		{errors.New("replacement transaction underpriced"), proposeBlockRetry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			require.Equal(t, tt.action, classifyProposeBlockError(tt.err))
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package proposer
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// encodedTxList is a transactions list along with its bytes encoded by the configured codec,
+// which will be proposed as they are.
+type encodedTxList struct {
+	txs   types.Transactions
+	bytes []byte
+}
+
+// encodeTxLists encodes the given transactions lists with the configured codec, the size limit of
+// the protocol is checked after encoding, and a transactions list which is still too large after encoding
+// will be split into smaller ones.
+func (p *Proposer) encodeTxLists(txLists []types.Transactions) ([]*encodedTxList, error) {
+	var encoded []*encodedTxList
+	for _, txs := range txLists {
+		lists, err := p.encodeTxList(txs)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, lists...)
+	}
+
+	return encoded, nil
+}
+
+// encodeTxList encodes the given transactions list, and splits it in halves recursively until all
+// encoded parts fit the size limit.
+func (p *Proposer) encodeTxList(txs types.Transactions) ([]*encodedTxList, error) {
+	txListBytes, err := rlp.EncodeToBytes(txs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transactions: %w", err)
+	}
+
+	encoded, err := p.txListCodec.Encode(txListBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transactions list: %w", err)
+	}
+
+	if uint64(len(encoded)) <= p.maxEncodedTxListBytes() {
+		return []*encodedTxList{{txs: txs, bytes: encoded}}, nil
+	}
+
+	if txs.Len() <= 1 {
+		log.Warn("Transaction too large to be proposed, skip it", "size", len(encoded), "codec", p.txListCodec.Name())
+		return nil, nil
+	}
+
+	left, err := p.encodeTxList(txs[:txs.Len()/2])
+	if err != nil {
+		return nil, err
+	}
+	right, err := p.encodeTxList(txs[txs.Len()/2:])
+	if err != nil {
+		return nil, err
+	}
+
+	return append(left, right...), nil
+}
+
+// prependForcedTxs puts the given forced transactions in a separate transactions list before the given
+// transactions lists, which are fetched from the L2 transaction pool, and removes the duplicated ones from them.
+func prependForcedTxs(forcedTxs types.Transactions, txLists []types.Transactions) []types.Transactions {
+	forced := make(map[common.Hash]struct{}, forcedTxs.Len())
+	for _, tx := range forcedTxs {
+		forced[tx.Hash()] = struct{}{}
+	}
+
+	result := []types.Transactions{forcedTxs}
+	for _, txs := range txLists {
+		var filtered types.Transactions
+		for _, tx := range txs {
+			if _, ok := forced[tx.Hash()]; !ok {
+				filtered = append(filtered, tx)
+			}
+		}
+		if filtered.Len() != 0 {
+			result = append(result, filtered)
+		}
+	}
+
+	return result
+}
+
+// maxEncodedTxListBytes returns the max size of an encoded transactions list which can be proposed.
+func (p *Proposer) maxEncodedTxListBytes() uint64 {
+	maxBytes := p.protocolConfigs.BlockMaxTxListBytes.Uint64()
+	if p.cfg.BlobAllowed && maxBytes > rpc.BlobDataSize {
+		maxBytes = rpc.BlobDataSize
+	}
+
+	return maxBytes
+}
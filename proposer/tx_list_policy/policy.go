@@ -0,0 +1,274 @@
+package policy
+
+import (
+	"container/heap"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Env is the environment a TxListPolicy is applied in.
+type Env struct {
+	Signer  types.Signer
+	BaseFee *big.Int
+}
+
+// TxListPolicy filters or reorders the transactions lists fetched from the L2 transaction pool,
+// before they are proposed.
+type TxListPolicy interface {
+	Name() string
+	Apply(txLists []types.Transactions, env *Env) ([]types.Transactions, error)
+}
+
+// Apply applies all the given policies in order, empty transactions lists will be removed.
+func Apply(policies []TxListPolicy, txLists []types.Transactions, env *Env) ([]types.Transactions, error) {
+	var err error
+	for _, p := range policies {
+		if txLists, err = p.Apply(txLists, env); err != nil {
+			return nil, err
+		}
+	}
+
+	var nonEmpty []types.Transactions
+	for _, txs := range txLists {
+		if txs.Len() != 0 {
+			nonEmpty = append(nonEmpty, txs)
+		}
+	}
+
+	return nonEmpty, nil
+}
+
+// filter keeps the transactions which satisfy the given condition. Once a transaction is dropped, all the
+// following transactions of the same sender are dropped too, since they would have nonce gaps.
+func filter(
+	txLists []types.Transactions,
+	signer types.Signer,
+	keep func(sender common.Address, tx *types.Transaction) bool,
+) ([]types.Transactions, error) {
+	var (
+		filteredLists = make([]types.Transactions, 0, len(txLists))
+		dropped       = make(map[common.Address]struct{})
+	)
+	for _, txs := range txLists {
+		var filtered types.Transactions
+		for _, tx := range txs {
+			sender, err := types.Sender(signer, tx)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := dropped[sender]; ok {
+				continue
+			}
+			if !keep(sender, tx) {
+				dropped[sender] = struct{}{}
+				continue
+			}
+
+			filtered = append(filtered, tx)
+		}
+		filteredLists = append(filteredLists, filtered)
+	}
+
+	return filteredLists, nil
+}
+
+// toSet converts the given addresses to a set.
+func toSet(addresses []common.Address) map[common.Address]struct{} {
+	set := make(map[common.Address]struct{}, len(addresses))
+	for _, address := range addresses {
+		set[address] = struct{}{}
+	}
+	return set
+}
+
+// SenderPolicy only keeps the transactions from the allowed senders, if the allow list is not empty,
+// and drops all transactions from the denied senders.
+type SenderPolicy struct {
+	allowed map[common.Address]struct{}
+	denied  map[common.Address]struct{}
+}
+
+// NewSenderPolicy creates a new SenderPolicy instance.
+func NewSenderPolicy(allowed []common.Address, denied []common.Address) *SenderPolicy {
+	return &SenderPolicy{allowed: toSet(allowed), denied: toSet(denied)}
+}
+
+// Name implements the TxListPolicy interface.
+func (p *SenderPolicy) Name() string { return "sender" }
+
+// Apply implements the TxListPolicy interface.
+func (p *SenderPolicy) Apply(txLists []types.Transactions, env *Env) ([]types.Transactions, error) {
+	return filter(txLists, env.Signer, func(sender common.Address, _ *types.Transaction) bool {
+		if _, ok := p.denied[sender]; ok {
+			return false
+		}
+		if len(p.allowed) == 0 {
+			return true
+		}
+		_, ok := p.allowed[sender]
+		return ok
+	})
+}
+
+// ContractPolicy drops all transactions calling the denied contracts.
+type ContractPolicy struct {
+	denied map[common.Address]struct{}
+}
+
+// NewContractPolicy creates a new ContractPolicy instance.
+func NewContractPolicy(denied []common.Address) *ContractPolicy {
+	return &ContractPolicy{denied: toSet(denied)}
+}
+
+// Name implements the TxListPolicy interface.
+func (p *ContractPolicy) Name() string { return "contract" }
+
+// Apply implements the TxListPolicy interface.
+func (p *ContractPolicy) Apply(txLists []types.Transactions, env *Env) ([]types.Transactions, error) {
+	return filter(txLists, env.Signer, func(_ common.Address, tx *types.Transaction) bool {
+		if tx.To() == nil {
+			return true
+		}
+		_, ok := p.denied[*tx.To()]
+		return !ok
+	})
+}
+
+// SenderCapPolicy limits the number of transactions of each sender in one proposing epoch.
+type SenderCapPolicy struct {
+	maxTxsPerSender uint64
+}
+
+// NewSenderCapPolicy creates a new SenderCapPolicy instance.
+func NewSenderCapPolicy(maxTxsPerSender uint64) *SenderCapPolicy {
+	return &SenderCapPolicy{maxTxsPerSender: maxTxsPerSender}
+}
+
+// Name implements the TxListPolicy interface.
+func (p *SenderCapPolicy) Name() string { return "senderCap" }
+
+// Apply implements the TxListPolicy interface.
+func (p *SenderCapPolicy) Apply(txLists []types.Transactions, env *Env) ([]types.Transactions, error) {
+	counts := make(map[common.Address]uint64)
+	return filter(txLists, env.Signer, func(sender common.Address, _ *types.Transaction) bool {
+		if counts[sender] >= p.maxTxsPerSender {
+			return false
+		}
+		counts[sender]++
+		return true
+	})
+}
+
+// MinTipPolicy drops the transactions whose effective gas tip at the current base fee is too low.
+type MinTipPolicy struct {
+	minTip *big.Int
+}
+
+// NewMinTipPolicy creates a new MinTipPolicy instance.
+func NewMinTipPolicy(minTip *big.Int) *MinTipPolicy {
+	return &MinTipPolicy{minTip: minTip}
+}
+
+// Name implements the TxListPolicy interface.
+func (p *MinTipPolicy) Name() string { return "minTip" }
+
+// Apply implements the TxListPolicy interface.
+func (p *MinTipPolicy) Apply(txLists []types.Transactions, env *Env) ([]types.Transactions, error) {
+	return filter(txLists, env.Signer, func(_ common.Address, tx *types.Transaction) bool {
+		return tx.EffectiveGasTipValue(env.BaseFee).Cmp(p.minTip) >= 0
+	})
+}
+
+// FeePriorityPolicy reorders the transactions in each transactions list by their effective gas tips at
+// the current base fee, in descending order, while keeping the nonce order of each sender's transactions.
+type FeePriorityPolicy struct{}
+
+// NewFeePriorityPolicy creates a new FeePriorityPolicy instance.
+func NewFeePriorityPolicy() *FeePriorityPolicy {
+	return &FeePriorityPolicy{}
+}
+
+// Name implements the TxListPolicy interface.
+func (p *FeePriorityPolicy) Name() string { return "feePriority" }
+
+// Apply implements the TxListPolicy interface.
+func (p *FeePriorityPolicy) Apply(txLists []types.Transactions, env *Env) ([]types.Transactions, error) {
+	sorted := make([]types.Transactions, 0, len(txLists))
+	for _, txs := range txLists {
+		// Group the transactions by senders, in their original order.
+		var (
+			senders []common.Address
+			queues  = make(map[common.Address]types.Transactions)
+		)
+		for _, tx := range txs {
+			sender, err := types.Sender(env.Signer, tx)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := queues[sender]; !ok {
+				senders = append(senders, sender)
+			}
+			queues[sender] = append(queues[sender], tx)
+		}
+
+		h := &tipHeap{baseFee: env.BaseFee}
+		for i, sender := range senders {
+			h.queues = append(h.queues, &senderQueue{txs: queues[sender], order: i})
+		}
+		heap.Init(h)
+
+		result := make(types.Transactions, 0, txs.Len())
+		for h.Len() > 0 {
+			queue := h.queues[0]
+			result = append(result, queue.txs[0])
+			if len(queue.txs) == 1 {
+				heap.Pop(h)
+				continue
+			}
+			queue.txs = queue.txs[1:]
+			heap.Fix(h, 0)
+		}
+		sorted = append(sorted, result)
+	}
+
+	return sorted, nil
+}
+
+// senderQueue is a sender's transactions in nonce order, with the sender's first appearance order in the
+// original transactions list.
+type senderQueue struct {
+	txs   types.Transactions
+	order int
+}
+
+// tipHeap is a max heap of senders' transactions queues, by the effective gas tips of their first
+// transactions, ties are broken by the original order.
+type tipHeap struct {
+	baseFee *big.Int
+	queues  []*senderQueue
+}
+
+func (h *tipHeap) Len() int { return len(h.queues) }
+
+func (h *tipHeap) Less(i, j int) bool {
+	cmp := h.queues[i].txs[0].EffectiveGasTipValue(h.baseFee).Cmp(h.queues[j].txs[0].EffectiveGasTipValue(h.baseFee))
+	if cmp == 0 {
+		return h.queues[i].order < h.queues[j].order
+	}
+	return cmp > 0
+}
+
+func (h *tipHeap) Swap(i, j int) { h.queues[i], h.queues[j] = h.queues[j], h.queues[i] }
+
+func (h *tipHeap) Push(x any) { h.queues = append(h.queues, x.(*senderQueue)) }
+
+func (h *tipHeap) Pop() any {
+	old := h.queues
+	n := len(old)
+	x := old[n-1]
+	h.queues = old[:n-1]
+	return x
+}
@@ -0,0 +1,118 @@
+package policy
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testChainID = big.NewInt(167)
+	testEnv     = &Env{Signer: types.LatestSignerForChainID(testChainID), BaseFee: big.NewInt(100)}
+	testKeyA, _ = crypto.GenerateKey()
+	testKeyB, _ = crypto.GenerateKey()
+	testAddrA   = crypto.PubkeyToAddress(testKeyA.PublicKey)
+	testAddrB   = crypto.PubkeyToAddress(testKeyB.PublicKey)
+	testTo      = common.BigToAddress(common.Big1)
+)
+
+func newTestTx(t *testing.T, key *ecdsa.PrivateKey, nonce uint64, tip int64, to *common.Address) *types.Transaction {
+	tx, err := types.SignNewTx(key, testEnv.Signer, &types.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tip),
+		GasFeeCap: big.NewInt(1000),
+		Gas:       21000,
+		To:        to,
+	})
+	require.Nil(t, err)
+	return tx
+}
+
+func TestSenderPolicy(t *testing.T) {
+	txLists := []types.Transactions{{
+		newTestTx(t, testKeyA, 0, 1, &testTo),
+		newTestTx(t, testKeyB, 0, 1, &testTo),
+	}}
+
+	filtered, err := Apply([]TxListPolicy{NewSenderPolicy(nil, []common.Address{testAddrA})}, txLists, testEnv)
+	require.Nil(t, err)
+	require.Len(t, filtered, 1)
+	require.Equal(t, txLists[0][1].Hash(), filtered[0][0].Hash())
+
+	filtered, err = Apply([]TxListPolicy{NewSenderPolicy([]common.Address{testAddrA}, nil)}, txLists, testEnv)
+	require.Nil(t, err)
+	require.Len(t, filtered[0], 1)
+	require.Equal(t, txLists[0][0].Hash(), filtered[0][0].Hash())
+
+	// All transactions dropped, the empty list is removed.
+	filtered, err = Apply(
+		[]TxListPolicy{NewSenderPolicy(nil, []common.Address{testAddrA, testAddrB})},
+		txLists,
+		testEnv,
+	)
+	require.Nil(t, err)
+	require.Empty(t, filtered)
+}
+
+func TestContractPolicy(t *testing.T) {
+	txLists := []types.Transactions{{
+		newTestTx(t, testKeyA, 0, 1, &testTo),
+		// Following transaction of the same sender is dropped too.
+		newTestTx(t, testKeyA, 1, 1, &testAddrB),
+		newTestTx(t, testKeyB, 0, 1, nil),
+	}}
+
+	filtered, err := Apply([]TxListPolicy{NewContractPolicy([]common.Address{testTo})}, txLists, testEnv)
+	require.Nil(t, err)
+	require.Len(t, filtered[0], 1)
+	require.Equal(t, txLists[0][2].Hash(), filtered[0][0].Hash())
+}
+
+func TestSenderCapPolicy(t *testing.T) {
+	txLists := []types.Transactions{
+		{newTestTx(t, testKeyA, 0, 1, &testTo), newTestTx(t, testKeyB, 0, 1, &testTo)},
+		{newTestTx(t, testKeyA, 1, 1, &testTo), newTestTx(t, testKeyA, 2, 1, &testTo)},
+	}
+
+	filtered, err := Apply([]TxListPolicy{NewSenderCapPolicy(2)}, txLists, testEnv)
+	require.Nil(t, err)
+	require.Len(t, filtered, 2)
+	require.Len(t, filtered[0], 2)
+	require.Len(t, filtered[1], 1)
+	require.Equal(t, uint64(1), filtered[1][0].Nonce())
+}
+
+func TestMinTipPolicy(t *testing.T) {
+	txLists := []types.Transactions{{
+		newTestTx(t, testKeyA, 0, 10, &testTo),
+		newTestTx(t, testKeyB, 0, 5, &testTo),
+	}}
+
+	filtered, err := Apply([]TxListPolicy{NewMinTipPolicy(big.NewInt(10))}, txLists, testEnv)
+	require.Nil(t, err)
+	require.Len(t, filtered[0], 1)
+	require.Equal(t, txLists[0][0].Hash(), filtered[0][0].Hash())
+}
+
+func TestFeePriorityPolicy(t *testing.T) {
+	txLists := []types.Transactions{{
+		newTestTx(t, testKeyA, 0, 1, &testTo),
+		newTestTx(t, testKeyA, 1, 50, &testTo),
+		newTestTx(t, testKeyB, 0, 10, &testTo),
+	}}
+
+	sorted, err := Apply([]TxListPolicy{NewFeePriorityPolicy()}, txLists, testEnv)
+	require.Nil(t, err)
+	require.Len(t, sorted[0], 3)
+
+	// Sender A's second transaction has the highest tip, but must follow its first one.
+	require.Equal(t, txLists[0][2].Hash(), sorted[0][0].Hash())
+	require.Equal(t, txLists[0][0].Hash(), sorted[0][1].Hash())
+	require.Equal(t, txLists[0][1].Hash(), sorted[0][2].Hash())
+}
@@ -0,0 +1,82 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/txlistcodec"
+	"github.com/taikoxyz/taiko-client/testutils"
+)
+
+func TestEncodeTxLists(t *testing.T) {
+	for _, name := range []string{txlistcodec.None, txlistcodec.Zlib, txlistcodec.Brotli} {
+		codec, err := txlistcodec.New(name)
+		require.Nil(t, err)
+
+		p := &Proposer{
+			cfg:             &Config{},
+			txListCodec:     codec,
+			protocolConfigs: &bindings.TaikoDataConfig{BlockMaxTxListBytes: big.NewInt(4096)},
+		}
+
+		// Fits the size limit after encoding.
+		encoded, err := p.encodeTxLists([]types.Transactions{newTestTxList(2, 512)})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(encoded))
+
+		// Too large, should be split.
+		var txs types.Transactions
+		for i := 0; i < 8; i++ {
+			txs = append(txs, newTestRandomTx(uint64(i), 1024))
+		}
+		encoded, err = p.encodeTxLists([]types.Transactions{txs})
+		require.Nil(t, err)
+		require.Greater(t, len(encoded), 1)
+
+		var count int
+		for _, txList := range encoded {
+			require.LessOrEqual(t, len(txList.bytes), 4096)
+
+			txListBytes, err := codec.Decode(txList.bytes)
+			require.Nil(t, err)
+
+			var decoded types.Transactions
+			require.Nil(t, rlp.DecodeBytes(txListBytes, &decoded))
+			for _, tx := range decoded {
+				require.Equal(t, txs[count].Hash(), tx.Hash())
+				count++
+			}
+		}
+		require.Equal(t, txs.Len(), count)
+
+		// A single transaction which can never fit the size limit is skipped.
+		encoded, err = p.encodeTxLists([]types.Transactions{{newTestRandomTx(0, 8192)}})
+		require.Nil(t, err)
+		require.Empty(t, encoded)
+	}
+}
+
+func newTestRandomTx(nonce uint64, dataSize int) *types.Transaction {
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: common.Big1,
+		Gas:      21000,
+		Value:    common.Big0,
+		Data:     testutils.RandomBytes(dataSize),
+	})
+}
+
+func TestPrependForcedTxs(t *testing.T) {
+	var (
+		forced = types.Transactions{newTestRandomTx(0, 32), newTestRandomTx(1, 32)}
+		pool   = types.Transactions{newTestRandomTx(2, 32), newTestRandomTx(3, 32)}
+	)
+
+	txLists := prependForcedTxs(forced, []types.Transactions{{forced[1], pool[0]}, {forced[0]}, {pool[1]}})
+	require.Equal(t, []types.Transactions{forced, {pool[0]}, {pool[1]}}, txLists)
+}
@@ -0,0 +1,69 @@
+package prover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+	proverDB "github.com/taikoxyz/taiko-client/prover/db"
+)
+
+var errProofJobsNotPersisted = errors.New("proof jobs are not persisted, please set the database path")
+
+// ProofJobs implements the server.ProverAdmin interface.
+func (p *Prover) ProofJobs() ([]*proverDB.ProofJob, error) {
+	if p.proofJobs == nil {
+		return nil, errProofJobsNotPersisted
+	}
+
+	return p.proofJobs.List()
+}
+
+// RequestProof implements the server.ProverAdmin interface.
+func (p *Prover) RequestProof(ctx context.Context, blockID *big.Int, minTier uint16) error {
+	blockInfo, err := p.rpc.TaikoL1.GetBlock(&bind.CallOpts{Context: ctx}, blockID.Uint64())
+	if err != nil {
+		return err
+	}
+	if blockInfo.BlockId != blockID.Uint64() {
+		return fmt.Errorf("block %d not found", blockID)
+	}
+
+	log.Info("Force proving a block", "blockID", blockID, "l1Height", blockInfo.ProposedIn, "minTier", minTier)
+
+	return p.requestProofByBlockID(blockID, new(big.Int).SetUint64(blockInfo.ProposedIn), minTier, nil)
+}
+
+// CancelProof implements the server.ProverAdmin interface.
+func (p *Prover) CancelProof(ctx context.Context, blockID *big.Int) error {
+	if p.proofJobs == nil {
+		return errProofJobsNotPersisted
+	}
+
+	job, err := p.proofJobs.Get(blockID)
+	if err != nil {
+		return err
+	}
+	if job == nil || job.Status != proverDB.ProofJobGenerating {
+		return fmt.Errorf("no proof is being generated for block %d", blockID)
+	}
+
+	proofSubmitter := p.getSubmitterByTier(job.Tier)
+	if proofSubmitter == nil {
+		return fmt.Errorf("no proof submitter found for tier %d", job.Tier)
+	}
+	if !proofSubmitter.Producer().Cancellable() {
+		return fmt.Errorf("proof generation of tier %d is not cancellable", job.Tier)
+	}
+
+	log.Info("Cancel proof generation", "blockID", blockID, "tier", job.Tier)
+
+	if err := proofSubmitter.Producer().Cancel(ctx, blockID); err != nil {
+		return err
+	}
+
+	return p.proofJobs.Delete(blockID)
+}
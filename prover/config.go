@@ -10,6 +10,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/taikoxyz/taiko-client/cmd/flags"
+	pricefeed "github.com/taikoxyz/taiko-client/pkg/price_feed"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	"github.com/urfave/cli/v2"
 )
 
@@ -24,6 +26,7 @@ type Config struct {
 	TaikoTokenAddress                       common.Address
 	AssignmentHookAddress                   common.Address
 	L1ProverPrivKey                         *ecdsa.PrivateKey
+	L1ProverSigner                          signer.Signer
 	ZKEvmRpcdEndpoint                       string
 	ZkEvmRpcdParamsPath                     string
 	StartingBlockID                         *big.Int
@@ -47,7 +50,14 @@ type Config struct {
 	MinSgxTierFee                           *big.Int
 	MinPseZkevmTierFee                      *big.Int
 	MinSgxAndPseZkevmTierFee                *big.Int
+	FeeTokenPriceFeed                       pricefeed.PriceFeed
+	ContractProverAddress                   common.Address
 	MaxExpiry                               time.Duration
+	AdminAPIToken                           string
+	DynamicPricing                          bool
+	PricingMargin                           uint64
+	PricingMaxSurge                         uint64
+	ProposerQuota                           uint64
 	MaxProposedIn                           uint64
 	MaxBlockSlippage                        uint64
 	DatabasePath                            string
@@ -59,9 +69,37 @@ type Config struct {
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
-	l1ProverPrivKey, err := crypto.ToECDSA(common.FromHex(c.String(flags.L1ProverPrivKey.Name)))
+	var (
+		l1ProverPrivKey *ecdsa.PrivateKey
+		err             error
+	)
+	if c.IsSet(flags.L1ProverPrivKey.Name) ||
+		(!c.IsSet(flags.SignerKeystore.Name) && !c.IsSet(flags.SignerRemoteURL.Name)) {
+		if l1ProverPrivKey, err = crypto.ToECDSA(common.FromHex(c.String(flags.L1ProverPrivKey.Name))); err != nil {
+			return nil, fmt.Errorf("invalid L1 prover private key: %w", err)
+		}
+	}
+
+	remoteSignerAddress := c.String(flags.SignerRemoteAddress.Name)
+	if c.IsSet(flags.SignerRemoteAddress.Name) && !common.IsHexAddress(remoteSignerAddress) {
+		return nil, fmt.Errorf("invalid --%s value: %s", flags.SignerRemoteAddress.Name, remoteSignerAddress)
+	}
+	l1ProverSigner, err := signer.New(&signer.Config{
+		PrivateKey:    l1ProverPrivKey,
+		KeystorePath:  c.String(flags.SignerKeystore.Name),
+		PasswordPath:  c.String(flags.SignerKeystorePassword.Name),
+		RemoteURL:     c.String(flags.SignerRemoteURL.Name),
+		RemoteAddress: common.HexToAddress(remoteSignerAddress),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("invalid L1 prover private key: %w", err)
+		return nil, fmt.Errorf("invalid L1 prover signer: %w", err)
+	}
+	// The prover assignments are signed over the raw data hashes, which Clef can't sign.
+	if remote, ok := l1ProverSigner.(*signer.RemoteSigner); ok && remote.IsClef(c.Context) {
+		return nil, fmt.Errorf(
+			"--%s: Clef can't sign the prover assignments, please use Web3Signer",
+			flags.SignerRemoteURL.Name,
+		)
 	}
 
 	var startingBlockID *big.Int
@@ -94,6 +132,23 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		proveBlockMaxTxGasTipCap = new(big.Int).SetUint64(c.Uint64(flags.ProveBlockMaxTxGasTipCap.Name))
 	}
 
+	var feeTokenPriceFeed pricefeed.PriceFeed
+	if c.IsSet(flags.FeeTokens.Name) {
+		prices, err := pricefeed.ParsePrices(c.String(flags.FeeTokens.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s value: %w", flags.FeeTokens.Name, err)
+		}
+		feeTokenPriceFeed = pricefeed.NewStaticPriceFeed(prices)
+	}
+
+	var contractProverAddress common.Address
+	if c.IsSet(flags.ContractProver.Name) {
+		if !common.IsHexAddress(c.String(flags.ContractProver.Name)) {
+			return nil, fmt.Errorf("invalid --%s value: %s", flags.ContractProver.Name, c.String(flags.ContractProver.Name))
+		}
+		contractProverAddress = common.HexToAddress(c.String(flags.ContractProver.Name))
+	}
+
 	var allowance = common.Big0
 	if c.IsSet(flags.Allowance.Name) {
 		amt, ok := new(big.Int).SetString(c.String(flags.Allowance.Name), 10)
@@ -137,6 +192,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		TaikoTokenAddress:                       common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
 		AssignmentHookAddress:                   common.HexToAddress(c.String(flags.ProverAssignmentHookAddress.Name)),
 		L1ProverPrivKey:                         l1ProverPrivKey,
+		L1ProverSigner:                          l1ProverSigner,
 		ZKEvmRpcdEndpoint:                       c.String(flags.ZkEvmRpcdEndpoint.Name),
 		ZkEvmRpcdParamsPath:                     c.String(flags.ZkEvmRpcdParamsPath.Name),
 		RaikoHostEndpoint:                       c.String(flags.RaikoHostEndpoint.Name),
@@ -162,7 +218,14 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		MinSgxTierFee:                           new(big.Int).SetUint64(c.Uint64(flags.MinSgxTierFee.Name)),
 		MinPseZkevmTierFee:                      new(big.Int).SetUint64(c.Uint64(flags.MinPseZkevmTierFee.Name)),
 		MinSgxAndPseZkevmTierFee:                new(big.Int).SetUint64(c.Uint64(flags.MinSgxAndPseZkevmTierFee.Name)),
+		FeeTokenPriceFeed:                       feeTokenPriceFeed,
+		ContractProverAddress:                   contractProverAddress,
 		MaxExpiry:                               c.Duration(flags.MaxExpiry.Name),
+		AdminAPIToken:                           c.String(flags.AdminAPIToken.Name),
+		DynamicPricing:                          c.Bool(flags.DynamicPricing.Name),
+		PricingMargin:                           c.Uint64(flags.PricingMargin.Name),
+		PricingMaxSurge:                         c.Uint64(flags.PricingMaxSurge.Name),
+		ProposerQuota:                           c.Uint64(flags.ProposerQuota.Name),
 		MaxBlockSlippage:                        c.Uint64(flags.MaxAcceptableBlockSlippage.Name),
 		MaxProposedIn:                           c.Uint64(flags.MaxProposedIn.Name),
 		DatabasePath:                            c.String(flags.DatabasePath.Name),
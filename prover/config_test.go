@@ -53,6 +53,11 @@ func (s *ProverTestSuite) TestNewConfigFromCliContextGuardianProver() {
 		s.True(c.ProveUnassignedBlocks)
 		s.Equal("dbPath", c.DatabasePath)
 		s.Equal(uint64(128), c.DatabaseCacheSize)
+		s.Equal("adminToken", c.AdminAPIToken)
+		s.True(c.DynamicPricing)
+		s.Equal(uint64(20), c.PricingMargin)
+		s.Equal(uint64(50), c.PricingMaxSurge)
+		s.Equal(uint64(4), c.ProposerQuota)
 		s.Equal(uint64(100), c.MaxProposedIn)
 		s.Equal(os.Getenv("ASSIGNMENT_HOOK_ADDRESS"), c.AssignmentHookAddress.String())
 		s.Equal(allowance, c.Allowance.String())
@@ -85,6 +90,11 @@ func (s *ProverTestSuite) TestNewConfigFromCliContextGuardianProver() {
 		"--" + flags.ProveUnassignedBlocks.Name,
 		"--" + flags.DatabasePath.Name, "dbPath",
 		"--" + flags.DatabaseCacheSize.Name, "128",
+		"--" + flags.AdminAPIToken.Name, "adminToken",
+		"--" + flags.DynamicPricing.Name,
+		"--" + flags.PricingMargin.Name, "20",
+		"--" + flags.PricingMaxSurge.Name, "50",
+		"--" + flags.ProposerQuota.Name, "4",
 		"--" + flags.MaxProposedIn.Name, "100",
 		"--" + flags.Allowance.Name, allowance,
 	}))
@@ -99,6 +109,31 @@ func (s *ProverTestSuite) TestNewConfigFromCliContextProverKeyError() {
 	}), "invalid L1 prover private key")
 }
 
+func (s *ProverTestSuite) TestNewConfigFromCliContextFeeTokensError() {
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.L1ProverPrivKey.Name, os.Getenv("L1_PROVER_PRIVATE_KEY"),
+		"--" + flags.FeeTokens.Name, "0x",
+	}), "invalid --prover.feeTokens value")
+}
+
+func (s *ProverTestSuite) TestNewConfigFromCliContextSignerError() {
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.SignerRemoteURL.Name, "http://localhost:9000",
+	}), "empty remote signer account address")
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.L1ProverPrivKey.Name, os.Getenv("L1_PROVER_PRIVATE_KEY"),
+		"--" + flags.SignerKeystore.Name, "keystore.json",
+	}), "exactly one of private key, keystore file and remote signer should be set")
+}
+
 func (s *ProverTestSuite) SetupApp() *cli.App {
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
@@ -109,6 +144,10 @@ func (s *ProverTestSuite) SetupApp() *cli.App {
 		&cli.StringFlag{Name: flags.TaikoL1Address.Name},
 		&cli.StringFlag{Name: flags.TaikoL2Address.Name},
 		&cli.StringFlag{Name: flags.L1ProverPrivKey.Name},
+		&cli.StringFlag{Name: flags.SignerKeystore.Name},
+		&cli.StringFlag{Name: flags.SignerKeystorePassword.Name},
+		&cli.StringFlag{Name: flags.SignerRemoteURL.Name},
+		&cli.StringFlag{Name: flags.SignerRemoteAddress.Name},
 		&cli.Uint64Flag{Name: flags.StartingBlockID.Name},
 		&cli.BoolFlag{Name: flags.Dummy.Name},
 		&cli.StringFlag{Name: flags.GuardianProver.Name},
@@ -121,9 +160,16 @@ func (s *ProverTestSuite) SetupApp() *cli.App {
 		&cli.Uint64Flag{Name: flags.MinOptimisticTierFee.Name},
 		&cli.Uint64Flag{Name: flags.MinSgxTierFee.Name},
 		&cli.Uint64Flag{Name: flags.MinPseZkevmTierFee.Name},
+		&cli.StringFlag{Name: flags.FeeTokens.Name},
+		&cli.StringFlag{Name: flags.ContractProver.Name},
 		&cli.Uint64Flag{Name: flags.ProveBlockTxGasLimit.Name},
 		&cli.StringFlag{Name: flags.DatabasePath.Name},
 		&cli.Uint64Flag{Name: flags.DatabaseCacheSize.Name},
+		&cli.StringFlag{Name: flags.AdminAPIToken.Name},
+		&cli.BoolFlag{Name: flags.DynamicPricing.Name},
+		&cli.Uint64Flag{Name: flags.PricingMargin.Name},
+		&cli.Uint64Flag{Name: flags.PricingMaxSurge.Name},
+		&cli.Uint64Flag{Name: flags.ProposerQuota.Name},
 		&cli.Uint64Flag{Name: flags.MaxProposedIn.Name},
 		&cli.StringFlag{Name: flags.ProverAssignmentHookAddress.Name},
 		&cli.StringFlag{Name: flags.Allowance.Name},
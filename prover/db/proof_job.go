@@ -0,0 +1,183 @@
+package db
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+var proofJobKeyPrefix = []byte("proofJob-")
+
+// ProofJobStatus is the status of a proof job.
+type ProofJobStatus string
+
+// All proof job statuses.
+const (
+	ProofJobQueued     ProofJobStatus = "queued"
+	ProofJobGenerating ProofJobStatus = "generating"
+	ProofJobGenerated  ProofJobStatus = "generated"
+	ProofJobSubmitted  ProofJobStatus = "submitted"
+	ProofJobConfirmed  ProofJobStatus = "confirmed"
+)
+
+// ProofJob is the proof generation and submission job of a proposed block.
+type ProofJob struct {
+	BlockID *big.Int       `json:"blockID"`
+	Status  ProofJobStatus `json:"status"`
+	// The tier of the requested proof, only set after the job's status becomes `generating`.
+	Tier uint16 `json:"tier"`
+	// The generated proof, only set after the job's status becomes `generated`.
+	Proof     *proofProducer.ProofWithHeader `json:"proof,omitempty"`
+	CreatedAt uint64                         `json:"createdAt"`
+	UpdatedAt uint64                         `json:"updatedAt"`
+}
+
+// ProofJobStore is a durable proof job store, so that the generated proofs can survive prover restarts.
+type ProofJobStore struct {
+	db    ethdb.KeyValueStore
+	mutex sync.Mutex
+}
+
+// NewProofJobStore creates a new ProofJobStore instance.
+func NewProofJobStore(db ethdb.KeyValueStore) *ProofJobStore {
+	return &ProofJobStore{db: db}
+}
+
+// BuildProofJobKey will build a proof job key for the given block ID.
+func BuildProofJobKey(blockID *big.Int) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, proofJobKeyPrefix...), blockID.Uint64())
+}
+
+// Get returns the proof job of the given block, returns nil if not found.
+func (s *ProofJobStore) Get(blockID *big.Int) (*ProofJob, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.get(blockID)
+}
+
+// Update updates the proof job of the given block with the given function, the job will be created
+// if not found.
+func (s *ProofJobStore) Update(blockID *big.Int, f func(job *ProofJob)) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	job, err := s.get(blockID)
+	if err != nil {
+		return err
+	}
+	if job == nil {
+		job = &ProofJob{BlockID: blockID, CreatedAt: uint64(time.Now().Unix())}
+	}
+	f(job)
+
+	return s.put(job)
+}
+
+// SetStatus sets the status of the proof job of the given block, the job will be created if not found.
+func (s *ProofJobStore) SetStatus(blockID *big.Int, status ProofJobStatus) error {
+	return s.Update(blockID, func(job *ProofJob) { job.Status = status })
+}
+
+// SetProof saves the generated proof, and sets the status of the job to `generated`.
+func (s *ProofJobStore) SetProof(proofWithHeader *proofProducer.ProofWithHeader) error {
+	return s.Update(proofWithHeader.BlockID, func(job *ProofJob) {
+		job.Status = ProofJobGenerated
+		job.Tier = proofWithHeader.Tier
+		job.Proof = proofWithHeader
+	})
+}
+
+// Delete deletes the proof job of the given block.
+func (s *ProofJobStore) Delete(blockID *big.Int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.db.Delete(BuildProofJobKey(blockID))
+}
+
+// DeleteUntil deletes all proof jobs whose block IDs are not greater than the given one.
+func (s *ProofJobStore) DeleteUntil(blockID *big.Int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	jobs, err := s.list()
+	if err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	for _, job := range jobs {
+		if job.BlockID.Cmp(blockID) <= 0 {
+			if err := batch.Delete(BuildProofJobKey(job.BlockID)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return batch.Write()
+}
+
+// List returns all proof jobs, in the order of block IDs.
+func (s *ProofJobStore) List() ([]*ProofJob, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.list()
+}
+
+// get returns the proof job of the given block, returns nil if not found.
+func (s *ProofJobStore) get(blockID *big.Int) (*ProofJob, error) {
+	key := BuildProofJobKey(blockID)
+
+	exists, err := s.db.Has(key)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	val, err := s.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	job := new(ProofJob)
+	if err := json.Unmarshal(val, job); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// put saves the given proof job.
+func (s *ProofJobStore) put(job *ProofJob) error {
+	job.UpdatedAt = uint64(time.Now().Unix())
+
+	val, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(BuildProofJobKey(job.BlockID), val)
+}
+
+// list returns all proof jobs, in the order of block IDs.
+func (s *ProofJobStore) list() ([]*ProofJob, error) {
+	it := s.db.NewIterator(proofJobKeyPrefix, nil)
+	defer it.Release()
+
+	var jobs []*ProofJob
+	for it.Next() {
+		job := new(ProofJob)
+		if err := json.Unmarshal(it.Value(), job); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, it.Error()
+}
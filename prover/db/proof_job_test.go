@@ -0,0 +1,66 @@
+package db
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-client/bindings"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+func Test_BuildProofJobKey(t *testing.T) {
+	assert.Equal(t, []byte("proofJob-\x00\x00\x00\x00\x00\x00\x01\x00"), BuildProofJobKey(big.NewInt(256)))
+}
+
+func Test_ProofJobStore(t *testing.T) {
+	s := NewProofJobStore(memorydb.New())
+
+	job, err := s.Get(big.NewInt(1))
+	assert.Nil(t, err)
+	assert.Nil(t, job)
+
+	for _, id := range []int64{3, 1, 2} {
+		assert.Nil(t, s.SetStatus(big.NewInt(id), ProofJobQueued))
+	}
+	assert.Nil(t, s.Update(big.NewInt(1), func(job *ProofJob) {
+		job.Status = ProofJobGenerating
+		job.Tier = 200
+	}))
+
+	proof := &proofProducer.ProofWithHeader{
+		BlockID: big.NewInt(2),
+		Meta:    &bindings.TaikoDataBlockMetadata{Id: 2},
+		Header:  &types.Header{Number: big.NewInt(2), Difficulty: common.Big0},
+		Proof:   []byte{0x01, 0x02},
+		Opts:    &proofProducer.ProofRequestOptions{BlockID: big.NewInt(2)},
+		Tier:    100,
+	}
+	assert.Nil(t, s.SetProof(proof))
+
+	jobs, err := s.List()
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 3)
+	assert.Equal(t, ProofJobGenerating, jobs[0].Status)
+	assert.Equal(t, uint16(200), jobs[0].Tier)
+	assert.NotZero(t, jobs[0].CreatedAt)
+	assert.Equal(t, ProofJobGenerated, jobs[1].Status)
+	assert.Equal(t, ProofJobQueued, jobs[2].Status)
+	assert.Equal(t, proof.Proof, jobs[1].Proof.Proof)
+	assert.Equal(t, proof.Header.Hash(), jobs[1].Proof.Header.Hash())
+	assert.Equal(t, proof.Tier, jobs[1].Tier)
+
+	assert.Nil(t, s.DeleteUntil(big.NewInt(2)))
+	jobs, err = s.List()
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, uint64(3), jobs[0].BlockID.Uint64())
+
+	assert.Nil(t, s.Delete(big.NewInt(3)))
+	jobs, err = s.List()
+	assert.Nil(t, err)
+	assert.Empty(t, jobs)
+}
@@ -3,7 +3,6 @@ package guardianproversender
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -12,10 +11,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	"github.com/taikoxyz/taiko-client/prover/db"
 )
 
@@ -35,7 +35,7 @@ type signedBlockReq struct {
 
 // GuardianProverBlockSender is responsible for signing and sending known blocks to the health check server.
 type GuardianProverBlockSender struct {
-	privateKey                *ecdsa.PrivateKey
+	proverSigner              signer.Signer
 	healthCheckServerEndpoint *url.URL
 	db                        ethdb.KeyValueStore
 	rpc                       *rpc.Client
@@ -44,14 +44,14 @@ type GuardianProverBlockSender struct {
 
 // New creates a new GuardianProverBlockSender instance.
 func New(
-	privateKey *ecdsa.PrivateKey,
+	proverSigner signer.Signer,
 	healthCheckServerEndpoint *url.URL,
 	db ethdb.KeyValueStore,
 	rpc *rpc.Client,
 	proverAddress common.Address,
 ) *GuardianProverBlockSender {
 	return &GuardianProverBlockSender{
-		privateKey:                privateKey,
+		proverSigner:              proverSigner,
 		healthCheckServerEndpoint: healthCheckServerEndpoint,
 		db:                        db,
 		rpc:                       rpc,
@@ -188,7 +188,13 @@ func (s *GuardianProverBlockSender) sign(ctx context.Context, blockID *big.Int)
 		"eventBlockID", blockID.Uint64(),
 	)
 
-	signed, err := crypto.Sign(header.Hash().Bytes(), s.privateKey)
+	// The block hash is the keccak256 hash of the RLP encoded header.
+	encoded, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signed, err := s.proverSigner.SignData(ctx, encoded)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -203,7 +209,7 @@ func (s *GuardianProverBlockSender) Close() error {
 
 // SendHeartbeat sends a heartbeat to the health check server.
 func (s *GuardianProverBlockSender) SendHeartbeat(ctx context.Context) error {
-	sig, err := crypto.Sign(crypto.Keccak256Hash([]byte("HEART_BEAT")).Bytes(), s.privateKey)
+	sig, err := s.proverSigner.SignData(ctx, []byte("HEART_BEAT"))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,166 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// DefaultProveBlockGas is the estimated gas used by a TaikoL1.proveBlock transaction.
+	DefaultProveBlockGas = 1_000_000
+	// Weight of the latest proving time in the moving average.
+	provingTimeWeight = 5
+	// Precision of the pressure, in basis points.
+	pressurePrecision = 10_000
+	// Proof generations which have not finished in time are considered as failed.
+	maxProvingTime = 24 * time.Hour
+)
+
+// GasPriceReader reads the current L1 gas price.
+type GasPriceReader interface {
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+}
+
+// Config contains all configurations for the pricing engine.
+type Config struct {
+	// Number of the proofs can be generated concurrently.
+	Capacity uint64
+	// Proving windows of all protocol tiers.
+	ProvingWindows map[uint16]time.Duration
+	// Estimated gas used by a TaikoL1.proveBlock transaction.
+	ProveBlockGas uint64
+	// Margin added to the proving costs, in percentage.
+	Margin uint64
+	// Maximum surcharge when the prover is fully loaded, in percentage.
+	MaxSurge uint64
+}
+
+// Engine prices the proof assignments dynamically. The price of a tier consists of the configured minimum
+// tier fee and the L1 gas cost of the TaikoL1.proveBlock transaction, with the configured margin added:
+//
+//	price = (minTierFee + gasPrice * proveBlockGas) * (100 + margin) / 100 * (100 + maxSurge * pressure) / 100
+//
+// The pressure of a tier is the maximum of the current queue depth against the prover capacity, and the
+// recent proving time of this tier against its proving window, which is capped at 1.
+type Engine struct {
+	cfg        *Config
+	cli        GasPriceReader
+	queueDepth func() int
+
+	provingTimes map[uint16]time.Duration
+	startedAt    map[uint64]time.Time
+	mutex        sync.Mutex
+}
+
+// New creates a new Engine instance, the given queueDepth function returns the number of the proofs being
+// generated currently.
+func New(cfg *Config, cli GasPriceReader, queueDepth func() int) (*Engine, error) {
+	if cfg.Capacity == 0 {
+		return nil, errors.New("empty prover capacity")
+	}
+	if cfg.ProveBlockGas == 0 {
+		cfg.ProveBlockGas = DefaultProveBlockGas
+	}
+
+	return &Engine{
+		cfg:          cfg,
+		cli:          cli,
+		queueDepth:   queueDepth,
+		provingTimes: make(map[uint16]time.Duration),
+		startedAt:    make(map[uint64]time.Time),
+	}, nil
+}
+
+// OnProofRequested records the time when the proof generation of the given block starts.
+func (e *Engine) OnProofRequested(blockID *big.Int) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	for id, startedAt := range e.startedAt {
+		if time.Since(startedAt) > maxProvingTime {
+			delete(e.startedAt, id)
+		}
+	}
+	e.startedAt[blockID.Uint64()] = time.Now()
+}
+
+// OnProofGenerated updates the recent proving time of the given tier.
+func (e *Engine) OnProofGenerated(blockID *big.Int, tier uint16) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	startedAt, ok := e.startedAt[blockID.Uint64()]
+	if !ok {
+		return
+	}
+	delete(e.startedAt, blockID.Uint64())
+
+	provingTime := time.Since(startedAt)
+	if avg, ok := e.provingTimes[tier]; ok {
+		provingTime = (avg*(provingTimeWeight-1) + provingTime) / provingTimeWeight
+	}
+	e.provingTimes[tier] = provingTime
+}
+
+// ProvingTime returns the recent proving time of the given tier, returns zero if there is no record.
+func (e *Engine) ProvingTime(tier uint16) time.Duration {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.provingTimes[tier]
+}
+
+// GasPrice returns the current L1 gas price, which should be fetched only once for all the tiers priced
+// in a single request.
+func (e *Engine) GasPrice(ctx context.Context) (*big.Int, error) {
+	return e.cli.SuggestGasPrice(ctx)
+}
+
+// TierFee returns the current price of the given tier at the given L1 gas price, in wei.
+func (e *Engine) TierFee(tier uint16, minTierFee *big.Int, gasPrice *big.Int) *big.Int {
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(e.cfg.ProveBlockGas))
+	fee.Add(fee, minTierFee)
+	fee.Mul(fee, new(big.Int).SetUint64(100+e.cfg.Margin))
+	fee.Div(fee, big.NewInt(100))
+
+	pressure := e.pressure(tier)
+	surge := new(big.Int).SetUint64(e.cfg.MaxSurge * pressure)
+	surge.Add(surge, big.NewInt(100*pressurePrecision))
+	fee.Mul(fee, surge)
+	fee.Div(fee, big.NewInt(100*pressurePrecision))
+
+	log.Debug(
+		"Tier fee priced",
+		"tier", tier,
+		"minTierFee", minTierFee,
+		"gasPrice", gasPrice,
+		"pressure", pressure,
+		"fee", fee,
+	)
+
+	return fee
+}
+
+// pressure returns the current pressure of the given tier, in basis points.
+func (e *Engine) pressure(tier uint16) uint64 {
+	var pressure uint64
+	if depth := e.queueDepth(); depth > 0 {
+		pressure = uint64(depth) * pressurePrecision / e.cfg.Capacity
+	}
+
+	if window := e.cfg.ProvingWindows[tier]; window > 0 {
+		if timePressure := uint64(e.ProvingTime(tier) * pressurePrecision / window); timePressure > pressure {
+			pressure = timePressure
+		}
+	}
+
+	if pressure > pressurePrecision {
+		return pressurePrecision
+	}
+	return pressure
+}
@@ -0,0 +1,81 @@
+package pricing
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+type testGasPriceReader struct {
+	gasPrice *big.Int
+}
+
+func (r *testGasPriceReader) SuggestGasPrice(_ context.Context) (*big.Int, error) {
+	return r.gasPrice, nil
+}
+
+func TestNewErr(t *testing.T) {
+	_, err := New(&Config{}, nil, nil)
+	require.ErrorContains(t, err, "empty prover capacity")
+}
+
+func TestTierFee(t *testing.T) {
+	var (
+		depth  = 0
+		engine *Engine
+		err    error
+	)
+	engine, err = New(&Config{
+		Capacity:       4,
+		ProvingWindows: map[uint16]time.Duration{200: time.Second},
+		ProveBlockGas:  100,
+		Margin:         10,
+		MaxSurge:       100,
+	}, &testGasPriceReader{gasPrice: big.NewInt(10)}, func() int { return depth })
+	require.Nil(t, err)
+
+	gasPrice, err := engine.GasPrice(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(10), gasPrice)
+
+	// (1000 + 10 * 100) * 110%
+	require.Equal(t, big.NewInt(2200), engine.TierFee(100, big.NewInt(1000), gasPrice))
+
+	// Half loaded, 50% surcharge.
+	depth = 2
+	require.Equal(t, big.NewInt(3300), engine.TierFee(100, big.NewInt(1000), gasPrice))
+
+	// Fully loaded, the pressure is capped.
+	depth = 8
+	require.Equal(t, big.NewInt(4400), engine.TierFee(100, big.NewInt(1000), gasPrice))
+
+	// The recent proving time of the tier exceeds its proving window.
+	depth = 0
+	engine.OnProofRequested(common.Big1)
+	time.Sleep(10 * time.Millisecond)
+	engine.OnProofGenerated(common.Big1, 200)
+	require.NotZero(t, engine.ProvingTime(200))
+	require.Zero(t, engine.ProvingTime(100))
+
+	engine.provingTimes[200] = 2 * time.Second
+	require.Equal(t, big.NewInt(4400), engine.TierFee(200, big.NewInt(1000), gasPrice))
+}
+
+func TestProvingTime(t *testing.T) {
+	engine, err := New(&Config{Capacity: 1}, nil, nil)
+	require.Nil(t, err)
+
+	// Unknown proof generations are ignored.
+	engine.OnProofGenerated(common.Big1, 100)
+	require.Zero(t, engine.ProvingTime(100))
+
+	engine.provingTimes[100] = 5 * time.Second
+	engine.startedAt[1] = time.Now().Add(-10 * time.Second)
+	engine.OnProofGenerated(common.Big1, 100)
+	require.InDelta(t, float64(6*time.Second), float64(engine.ProvingTime(100)), float64(100*time.Millisecond))
+	require.Empty(t, engine.startedAt)
+}
@@ -0,0 +1,106 @@
+package prover
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/log"
+	proverDB "github.com/taikoxyz/taiko-client/prover/db"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+// resumeProofJobs resubmits the proofs which have been generated but not submitted before the restart. The
+// queued and generating jobs will be resumed when the prover rescans the proposed blocks from the last
+// verified block.
+func (p *Prover) resumeProofJobs() error {
+	if p.proofJobs == nil {
+		return nil
+	}
+
+	jobs, err := p.proofJobs.List()
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		if job.Status != proverDB.ProofJobGenerated || job.Proof == nil {
+			continue
+		}
+
+		log.Info("Resubmit the generated proof", "blockID", job.BlockID, "tier", job.Proof.Tier)
+		p.submitProofOp(p.ctx, job.Proof)
+	}
+
+	return nil
+}
+
+// getProofJob returns the proof job of the given block, returns nil if the job is not found, or
+// there is no database configured.
+func (p *Prover) getProofJob(blockID *big.Int) *proverDB.ProofJob {
+	if p.proofJobs == nil {
+		return nil
+	}
+
+	job, err := p.proofJobs.Get(blockID)
+	if err != nil {
+		log.Warn("Failed to get proof job", "blockID", blockID, "error", err)
+		return nil
+	}
+
+	return job
+}
+
+// setProofJobStatus updates the status of the proof job of the given block.
+func (p *Prover) setProofJobStatus(blockID *big.Int, status proverDB.ProofJobStatus) {
+	p.updateProofJob(blockID, func(job *proverDB.ProofJob) { job.Status = status })
+}
+
+// startProofJob marks the proof job of the given block as generating a proof with the given tier, and
+// records the start time for the pricing engine.
+func (p *Prover) startProofJob(blockID *big.Int, tier uint16) {
+	if p.pricingEngine != nil {
+		p.pricingEngine.OnProofRequested(blockID)
+	}
+
+	p.updateProofJob(blockID, func(job *proverDB.ProofJob) {
+		job.Status = proverDB.ProofJobGenerating
+		job.Tier = tier
+	})
+}
+
+// updateProofJob updates the proof job of the given block with the given function.
+func (p *Prover) updateProofJob(blockID *big.Int, f func(job *proverDB.ProofJob)) {
+	if p.proofJobs == nil {
+		return
+	}
+
+	if err := p.proofJobs.Update(blockID, f); err != nil {
+		log.Warn("Failed to update proof job", "blockID", blockID, "error", err)
+	}
+}
+
+// saveProof saves the generated proof, so that it can be resubmitted after a restart, and updates the
+// recent proving time for the pricing engine.
+func (p *Prover) saveProof(proofWithHeader *proofProducer.ProofWithHeader) {
+	if p.pricingEngine != nil {
+		p.pricingEngine.OnProofGenerated(proofWithHeader.BlockID, proofWithHeader.Tier)
+	}
+
+	if p.proofJobs == nil {
+		return
+	}
+
+	if err := p.proofJobs.SetProof(proofWithHeader); err != nil {
+		log.Warn("Failed to save generated proof", "blockID", proofWithHeader.BlockID, "error", err)
+	}
+}
+
+// deleteProofJob deletes the proof job of the given block.
+func (p *Prover) deleteProofJob(blockID *big.Int) {
+	if p.proofJobs == nil {
+		return
+	}
+
+	if err := p.proofJobs.Delete(blockID); err != nil {
+		log.Warn("Failed to delete proof job", "blockID", blockID, "error", err)
+	}
+}
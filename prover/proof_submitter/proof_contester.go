@@ -2,7 +2,6 @@ package submitter
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
@@ -15,6 +14,7 @@ import (
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 	"github.com/taikoxyz/taiko-client/prover/proof_submitter/transaction"
 )
@@ -33,7 +33,8 @@ type ProofContester struct {
 // NewProofContester creates a new ProofContester instance.
 func NewProofContester(
 	rpcClient *rpc.Client,
-	proverPrivKey *ecdsa.PrivateKey,
+	proverSigner signer.Signer,
+	contractProverAddress common.Address,
 	proveBlockTxGasLimit *uint64,
 	txReplacementTipMultiplier uint64,
 	proveBlockMaxTxGasTipCap *big.Int,
@@ -56,15 +57,21 @@ func NewProofContester(
 		txGasLimit = new(big.Int).SetUint64(*proveBlockTxGasLimit)
 	}
 
+	txBuilder, err := transaction.NewProveBlockTxBuilder(
+		rpcClient,
+		proverSigner,
+		contractProverAddress,
+		txGasLimit,
+		proveBlockMaxTxGasTipCap,
+		new(big.Int).SetUint64(txReplacementTipMultiplier),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ProofContester{
-		rpc: rpcClient,
-		txBuilder: transaction.NewProveBlockTxBuilder(
-			rpcClient,
-			proverPrivKey,
-			txGasLimit,
-			proveBlockMaxTxGasTipCap,
-			new(big.Int).SetUint64(txReplacementTipMultiplier),
-		),
+		rpc:             rpcClient,
+		txBuilder:       txBuilder,
 		txSender:        transaction.NewSender(rpcClient, retryInterval, &submissionMaxRetry, waitReceiptTimeout),
 		l2SignalService: l2SignalService,
 		graffiti:        rpc.StringToBytes32(graffiti),
@@ -2,7 +2,6 @@ package submitter
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
@@ -10,12 +9,12 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/metrics"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	anchorTxValidator "github.com/taikoxyz/taiko-client/prover/anchor_tx_validator"
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 	"github.com/taikoxyz/taiko-client/prover/proof_submitter/transaction"
@@ -45,7 +44,8 @@ func New(
 	proofProducer proofProducer.ProofProducer,
 	resultCh chan *proofProducer.ProofWithHeader,
 	taikoL2Address common.Address,
-	proverPrivKey *ecdsa.PrivateKey,
+	proverSigner signer.Signer,
+	contractProverAddress common.Address,
 	graffiti string,
 	submissionMaxRetry uint64,
 	retryInterval time.Duration,
@@ -84,20 +84,33 @@ func New(
 		txGasLimit = new(big.Int).SetUint64(*proveBlockTxGasLimit)
 	}
 
+	txBuilder, err := transaction.NewProveBlockTxBuilder(
+		rpcClient,
+		proverSigner,
+		contractProverAddress,
+		txGasLimit,
+		proveBlockMaxTxGasTipCap,
+		new(big.Int).SetUint64(txReplacementTipMultiplier),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The proofs are submitted through the smart contract prover if configured, so it is the prover
+	// seen by TaikoL1.
+	proverAddress := proverSigner.Address()
+	if contractProverAddress != (common.Address{}) {
+		proverAddress = contractProverAddress
+	}
+
 	return &ProofSubmitter{
 		rpc:             rpcClient,
 		proofProducer:   proofProducer,
 		resultCh:        resultCh,
 		anchorValidator: anchorValidator,
-		txBuilder: transaction.NewProveBlockTxBuilder(
-			rpcClient,
-			proverPrivKey,
-			txGasLimit,
-			proveBlockMaxTxGasTipCap,
-			new(big.Int).SetUint64(txReplacementTipMultiplier),
-		),
+		txBuilder:       txBuilder,
 		txSender:        transaction.NewSender(rpcClient, retryInterval, maxRetry, waitReceiptTimeout),
-		proverAddress:   crypto.PubkeyToAddress(proverPrivKey.PublicKey),
+		proverAddress:   proverAddress,
 		l1SignalService: l1SignalService,
 		l2SignalService: l2SignalService,
 		taikoL2Address:  taikoL2Address,
@@ -16,6 +16,7 @@ import (
 	"github.com/taikoxyz/taiko-client/driver/chain_syncer/beaconsync"
 	"github.com/taikoxyz/taiko-client/driver/chain_syncer/calldata"
 	"github.com/taikoxyz/taiko-client/driver/state"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	"github.com/taikoxyz/taiko-client/proposer"
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 	"github.com/taikoxyz/taiko-client/testutils"
@@ -43,7 +44,8 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 		&proofProducer.OptimisticProofProducer{},
 		s.proofCh,
 		common.HexToAddress(os.Getenv("TAIKO_L2_ADDRESS")),
-		l1ProverPrivKey,
+		signer.NewLocalSigner(l1ProverPrivKey),
+		common.Address{},
 		"test",
 		1,
 		12*time.Second,
@@ -55,7 +57,8 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 	s.Nil(err)
 	s.contester, err = NewProofContester(
 		s.RPCClient,
-		l1ProverPrivKey,
+		signer.NewLocalSigner(l1ProverPrivKey),
+		common.Address{},
 		nil,
 		2,
 		common.Big256,
@@ -78,6 +81,8 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 		testState,
 		tracker,
 		common.HexToAddress(os.Getenv("L1_SIGNAL_SERVICE_CONTRACT_ADDRESS")),
+		nil,
+		nil,
 	)
 	s.Nil(err)
 
@@ -2,18 +2,17 @@ package transaction
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"math/big"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 )
 
 // TxBuilder will build a transaction with the given nonce.
@@ -22,31 +21,44 @@ type TxBuilder func(nonce *big.Int) (*types.Transaction, error)
 // ProveBlockTxBuilder is responsible for building ProveBlock transactions.
 type ProveBlockTxBuilder struct {
 	rpc              *rpc.Client
-	proverPrivateKey *ecdsa.PrivateKey
+	proverSigner     signer.Signer
 	proverAddress    common.Address
+	taikoL1          *bindings.TaikoL1Client
 	gasLimit         *big.Int
 	gasTipCap        *big.Int
 	gasTipMultiplier *big.Int
 	mutex            *sync.Mutex
 }
 
-// NewProveBlockTxBuilder creates a new ProveBlockTxBuilder instance.
+// NewProveBlockTxBuilder creates a new ProveBlockTxBuilder instance, if a smart contract prover is given,
+// the proofs will be submitted through its `proveBlock(uint64,bytes)` method, which shares the same ABI
+// as TaikoL1's and forwards the proofs to TaikoL1.
 func NewProveBlockTxBuilder(
 	rpc *rpc.Client,
-	proverPrivateKey *ecdsa.PrivateKey,
+	proverSigner signer.Signer,
+	contractProverAddress common.Address,
 	gasLimit *big.Int,
 	gasTipCap *big.Int,
 	gasTipMultiplier *big.Int,
-) *ProveBlockTxBuilder {
+) (*ProveBlockTxBuilder, error) {
+	taikoL1 := rpc.TaikoL1
+	if contractProverAddress != (common.Address{}) {
+		var err error
+		if taikoL1, err = bindings.NewTaikoL1Client(contractProverAddress, rpc.L1); err != nil {
+			return nil, err
+		}
+	}
+
 	return &ProveBlockTxBuilder{
 		rpc:              rpc,
-		proverPrivateKey: proverPrivateKey,
-		proverAddress:    crypto.PubkeyToAddress(proverPrivateKey.PublicKey),
+		proverSigner:     proverSigner,
+		proverAddress:    proverSigner.Address(),
+		taikoL1:          taikoL1,
 		gasLimit:         gasLimit,
 		gasTipCap:        gasTipCap,
 		gasTipMultiplier: gasTipMultiplier,
 		mutex:            new(sync.Mutex),
-	}
+	}, nil
 }
 
 // Build creates a new TaikoL1.ProveBlock transaction with the given nonce.
@@ -62,7 +74,7 @@ func (a *ProveBlockTxBuilder) Build(
 		a.mutex.Lock()
 		defer a.mutex.Unlock()
 
-		txOpts, err := getProveBlocksTxOpts(ctx, a.rpc.L1, a.rpc.L1ChainID, a.proverPrivateKey)
+		txOpts, err := getProveBlocksTxOpts(ctx, a.rpc.L1, a.rpc.L1ChainID, a.proverSigner)
 		if err != nil {
 			return nil, err
 		}
@@ -101,25 +113,22 @@ func (a *ProveBlockTxBuilder) Build(
 			if err != nil {
 				return nil, err
 			}
-			return a.rpc.TaikoL1.ProveBlock(txOpts, blockID.Uint64(), input)
+			return a.taikoL1.ProveBlock(txOpts, blockID.Uint64(), input)
 		}
 
 		return a.rpc.GuardianProver.Approve(txOpts, *meta, *transition, *tierProof)
 	}
 }
 
-// getProveBlocksTxOpts creates a bind.TransactOpts instance using the given private key.
+// getProveBlocksTxOpts creates a bind.TransactOpts instance using the given signer.
 // Used for creating TaikoL1.proveBlock and TaikoL1.proveBlockInvalid transactions.
 func getProveBlocksTxOpts(
 	ctx context.Context,
 	cli *rpc.EthClient,
 	chainID *big.Int,
-	proverPrivKey *ecdsa.PrivateKey,
+	proverSigner signer.Signer,
 ) (*bind.TransactOpts, error) {
-	opts, err := bind.NewKeyedTransactorWithChainID(proverPrivKey, chainID)
-	if err != nil {
-		return nil, err
-	}
+	opts := signer.NewTransactOpts(ctx, proverSigner, chainID)
 	gasTipCap, err := cli.SuggestGasTipCap(ctx)
 	if err != nil {
 		if rpc.IsMaxPriorityFeePerGasNotFoundError(err) {
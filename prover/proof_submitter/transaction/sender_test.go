@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 	"github.com/taikoxyz/taiko-client/testutils"
 )
@@ -35,7 +36,15 @@ func (s *TransactionTestSuite) SetupTest() {
 	s.Nil(err)
 
 	s.sender = NewSender(s.RPCClient, 5*time.Second, nil, 1*time.Minute)
-	s.builder = NewProveBlockTxBuilder(s.RPCClient, l1ProverPrivKey, nil, common.Big256, common.Big2)
+	s.builder, err = NewProveBlockTxBuilder(
+		s.RPCClient,
+		signer.NewLocalSigner(l1ProverPrivKey),
+		common.Address{},
+		nil,
+		common.Big256,
+		common.Big2,
+	)
+	s.Nil(err)
 }
 
 func (s *TransactionTestSuite) TestIsSubmitProofTxErrorRetryable() {
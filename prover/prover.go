@@ -2,7 +2,6 @@ package prover
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
@@ -15,7 +14,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/ethdb/leveldb"
 	"github.com/ethereum/go-ethereum/event"
@@ -25,7 +23,10 @@ import (
 	"github.com/taikoxyz/taiko-client/metrics"
 	eventIterator "github.com/taikoxyz/taiko-client/pkg/chain_iterator/event_iterator"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+	proverDB "github.com/taikoxyz/taiko-client/prover/db"
 	guardianproversender "github.com/taikoxyz/taiko-client/prover/guardian_prover_sender"
+	"github.com/taikoxyz/taiko-client/prover/pricing"
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 	proofSubmitter "github.com/taikoxyz/taiko-client/prover/proof_submitter"
 	"github.com/taikoxyz/taiko-client/prover/server"
@@ -40,9 +41,12 @@ var (
 // Prover keep trying to prove new proposed blocks valid/invalid.
 type Prover struct {
 	// Configurations
-	cfg              *Config
-	proverAddress    common.Address
-	proverPrivateKey *ecdsa.PrivateKey
+	cfg           *Config
+	proverAddress common.Address
+	proverSigner  signer.Signer
+	// Address assigned to prove the blocks, which is the smart contract prover if configured, otherwise
+	// the prover signer's address.
+	assignedProverAddress common.Address
 
 	// Clients
 	rpc *rpc.Client
@@ -82,6 +86,8 @@ type Prover struct {
 
 	// Proof related
 	proofGenerationCh chan *proofProducer.ProofWithHeader
+	proofJobs         *proverDB.ProofJobStore
+	pricingEngine     *pricing.Engine
 
 	// Concurrency guards
 	proposeConcurrencyGuard     chan struct{}
@@ -105,7 +111,9 @@ func (p *Prover) InitFromCli(ctx context.Context, c *cli.Context) error {
 func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 	p.cfg = cfg
 	p.ctx = ctx
-	p.proverPrivateKey = cfg.L1ProverPrivKey
+	if p.proverSigner = cfg.L1ProverSigner; p.proverSigner == nil {
+		p.proverSigner = signer.NewLocalSigner(cfg.L1ProverPrivKey)
+	}
 
 	// Clients
 	if p.rpc, err = rpc.NewClient(p.ctx, &rpc.ClientConfig{
@@ -131,7 +139,11 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 
 	log.Info("Protocol configs", "configs", p.protocolConfigs)
 
-	p.proverAddress = crypto.PubkeyToAddress(p.cfg.L1ProverPrivKey.PublicKey)
+	p.proverAddress = p.proverSigner.Address()
+	p.assignedProverAddress = p.proverAddress
+	if cfg.ContractProverAddress != (common.Address{}) {
+		p.assignedProverAddress = cfg.ContractProverAddress
+	}
 
 	chBufferSize := p.protocolConfigs.BlockMaxProposals
 	p.blockProposedCh = make(chan *bindings.TaikoL1ClientBlockProposed, chBufferSize)
@@ -229,7 +241,8 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 			producer,
 			p.proofGenerationCh,
 			p.cfg.TaikoL2Address,
-			p.cfg.L1ProverPrivKey,
+			p.proverSigner,
+			p.cfg.ContractProverAddress,
 			p.cfg.Graffiti,
 			p.cfg.ProofSubmissionMaxRetry,
 			p.cfg.BackOffRetryInterval,
@@ -247,7 +260,8 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 	// Proof contester
 	p.proofContester, err = proofSubmitter.NewProofContester(
 		p.rpc,
-		p.cfg.L1ProverPrivKey,
+		p.proverSigner,
+		p.cfg.ContractProverAddress,
 		p.cfg.ProveBlockGasLimit,
 		p.cfg.ProveBlockTxReplacementMultiplier,
 		p.cfg.ProveBlockMaxTxGasTipCap,
@@ -272,11 +286,37 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		); err != nil {
 			return err
 		}
+		p.proofJobs = proverDB.NewProofJobStore(db)
+	}
+
+	// Pricing engine
+	if cfg.DynamicPricing {
+		pricingConfig := &pricing.Config{
+			Capacity:       cfg.Capacity,
+			ProvingWindows: make(map[uint16]time.Duration),
+			Margin:         cfg.PricingMargin,
+			MaxSurge:       cfg.PricingMaxSurge,
+		}
+		if cfg.ProveBlockGasLimit != nil {
+			pricingConfig.ProveBlockGas = *cfg.ProveBlockGasLimit
+		}
+		for _, tier := range p.tiers {
+			pricingConfig.ProvingWindows[tier.ID] = time.Duration(tier.ProvingWindow) * time.Minute
+		}
+		if p.pricingEngine, err = pricing.New(
+			pricingConfig,
+			p.rpc.L1,
+			func() int { return len(p.proposeConcurrencyGuard) + p.srv.OutstandingAssignments() },
+		); err != nil {
+			return err
+		}
 	}
 
 	// Prover server
 	proverServerOpts := &server.NewProverServerOpts{
-		ProverPrivateKey:         p.cfg.L1ProverPrivKey,
+		ProverSigner:             p.proverSigner,
+		FeeTokenPriceFeed:        p.cfg.FeeTokenPriceFeed,
+		ContractProverAddress:    p.cfg.ContractProverAddress,
 		MinOptimisticTierFee:     p.cfg.MinOptimisticTierFee,
 		MinSgxTierFee:            p.cfg.MinSgxTierFee,
 		MinPseZkevmTierFee:       p.cfg.MinPseZkevmTierFee,
@@ -291,6 +331,10 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		LivenessBond:             protocolConfigs.LivenessBond,
 		IsGuardian:               p.IsGuardianProver(),
 		DB:                       db,
+		Admin:                    p,
+		AdminToken:               p.cfg.AdminAPIToken,
+		PricingEngine:            p.pricingEngine,
+		ProposerQuota:            p.cfg.ProposerQuota,
 	}
 	if p.srv, err = server.New(proverServerOpts); err != nil {
 		return err
@@ -304,7 +348,7 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		}
 
 		p.guardianProverSender = guardianproversender.New(
-			p.cfg.L1ProverPrivKey,
+			p.proverSigner,
 			p.cfg.GuardianProverHealthCheckServerEndpoint,
 			db,
 			p.rpc,
@@ -316,8 +360,9 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 }
 
 // setApprovalAmount will set the allowance on the TaikoToken contract for the
-// configured proverAddress as owner and the contract as spender,
-// if `--prover.allowance` flag is provided for allowance.
+// configured assignedProverAddress as owner and the contract as spender,
+// if `--prover.allowance` flag is provided for allowance. A smart contract prover
+// pays the liveness bonds itself, so its allowance can only be checked here.
 func (p *Prover) setApprovalAmount(ctx context.Context, contract common.Address) error {
 	if p.cfg.Allowance == nil || p.cfg.Allowance.Cmp(common.Big0) != 1 {
 		log.Info("Skipping setting approval, `--prover.allowance` flag not set")
@@ -326,7 +371,7 @@ func (p *Prover) setApprovalAmount(ctx context.Context, contract common.Address)
 
 	allowance, err := p.rpc.TaikoToken.Allowance(
 		&bind.CallOpts{Context: ctx},
-		p.proverAddress,
+		p.assignedProverAddress,
 		contract,
 	)
 	if err != nil {
@@ -345,14 +390,14 @@ func (p *Prover) setApprovalAmount(ctx context.Context, contract common.Address)
 		return nil
 	}
 
-	opts, err := bind.NewKeyedTransactorWithChainID(
-		p.cfg.L1ProverPrivKey,
-		p.rpc.L1ChainID,
-	)
-	if err != nil {
-		return err
+	if p.assignedProverAddress != p.proverAddress {
+		return fmt.Errorf(
+			"insufficient allowance of the contract prover %s, which must be approved by the contract itself",
+			p.assignedProverAddress,
+		)
 	}
-	opts.Context = ctx
+
+	opts := signer.NewTransactOpts(ctx, p.proverSigner, p.rpc.L1ChainID)
 
 	log.Info("Approving the contract for taiko token", "allowance", p.cfg.Allowance.String(), "contract", contract)
 
@@ -378,7 +423,7 @@ func (p *Prover) setApprovalAmount(ctx context.Context, contract common.Address)
 
 	if allowance, err = p.rpc.TaikoToken.Allowance(
 		&bind.CallOpts{Context: ctx},
-		p.proverAddress,
+		p.assignedProverAddress,
 		contract,
 	); err != nil {
 		return err
@@ -413,6 +458,10 @@ func (p *Prover) Start() error {
 
 	go p.eventLoop()
 
+	if err := p.resumeProofJobs(); err != nil {
+		log.Error("Failed to resume proof jobs", "error", err)
+	}
+
 	return nil
 }
 
@@ -445,6 +494,7 @@ func (p *Prover) eventLoop() {
 		case <-p.ctx.Done():
 			return
 		case proofWithHeader := <-p.proofGenerationCh:
+			p.saveProof(proofWithHeader)
 			p.submitProofOp(p.ctx, proofWithHeader)
 		case <-p.proveNotify:
 			if err := p.proveOp(); err != nil {
@@ -523,6 +573,9 @@ func (p *Prover) onBlockProposed(
 	event *bindings.TaikoL1ClientBlockProposed,
 	end eventIterator.EndBlockProposedEventIterFunc,
 ) error {
+	// The proposal of the assignment has been seen on chain, release its reserved capacity.
+	p.srv.OnBlockProposed(event.Meta.BlobHash)
+
 	// If we are operating as a guardian prover,
 	// we should sign all seen proposed blocks as soon as possible.
 	go func() {
@@ -633,8 +686,23 @@ func (p *Prover) onBlockProposed(
 	p.l1Current = newL1Current
 	p.lastHandledBlockID = event.BlockId.Uint64()
 
+	// Skip the blocks whose proofs have already been generated before the restart.
+	if job := p.getProofJob(event.BlockId); job != nil && job.Status != proverDB.ProofJobQueued &&
+		job.Status != proverDB.ProofJobGenerating {
+		log.Info("Proof job already exists, skip proving", "blockID", event.BlockId, "status", job.Status)
+		return nil
+	}
+	p.setProofJobStatus(event.BlockId, proverDB.ProofJobQueued)
+
 	// Try generating a proof for the proposed block with the given backoff policy.
 	go func() {
+		// The job won't be queued anymore once the proof has been requested, otherwise no proof is needed.
+		defer func() {
+			if job := p.getProofJob(event.BlockId); job != nil && job.Status == proverDB.ProofJobQueued {
+				p.deleteProofJob(event.BlockId)
+			}
+		}()
+
 		if err := backoff.Retry(
 			func() error {
 				p.proposeConcurrencyGuard <- struct{}{}
@@ -678,7 +746,7 @@ func (p *Prover) handleNewBlockProposedEvent(ctx context.Context, e *bindings.Ta
 		p.ctx,
 		p.rpc,
 		e.BlockId,
-		p.proverAddress,
+		p.assignedProverAddress,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to check whether the L2 block needs a new proof: %w", err)
@@ -739,7 +807,7 @@ func (p *Prover) handleNewBlockProposedEvent(ctx context.Context, e *bindings.Ta
 			"prover", e.AssignedProver,
 			"expiresAt", provingWindowExpiresAt,
 		)
-		if e.AssignedProver == p.proverAddress {
+		if e.AssignedProver == p.assignedProverAddress {
 			log.Warn(
 				"Assigned prover is the current prover, but the proving window has expired, skip proving",
 				"blockID", e.BlockId,
@@ -760,7 +828,7 @@ func (p *Prover) handleNewBlockProposedEvent(ctx context.Context, e *bindings.Ta
 	} else {
 		// If the proving window is not expired, we need to check if the current prover is the assigned prover,
 		// if no and the current prover wants to prove unassigned blocks, then we should wait for its expiration.
-		if e.AssignedProver != p.proverAddress {
+		if e.AssignedProver != p.assignedProverAddress {
 			log.Info(
 				"Proposed block is not provable",
 				"blockID", e.BlockId,
@@ -796,6 +864,7 @@ func (p *Prover) handleNewBlockProposedEvent(ctx context.Context, e *bindings.Ta
 	metrics.ProverProofsAssigned.Inc(1)
 
 	if proofSubmitter := p.selectSubmitter(e.Meta.MinTier); proofSubmitter != nil {
+		p.startProofJob(e.BlockId, proofSubmitter.Tier())
 		return proofSubmitter.RequestProof(ctx, e)
 	}
 
@@ -861,6 +930,7 @@ func (p *Prover) submitProofOp(ctx context.Context, proofWithHeader *proofProduc
 					return err
 				}
 
+				p.setProofJobStatus(proofWithHeader.BlockID, proverDB.ProofJobSubmitted)
 				return nil
 			},
 			backoff.WithMaxRetries(backoff.NewConstantBackOff(p.cfg.BackOffRetryInterval), p.cfg.BackOffMaxRetrys),
@@ -944,6 +1014,13 @@ func (p *Prover) onBlockVerified(_ context.Context, e *bindings.TaikoL1ClientBlo
 		"prover", e.Prover,
 	)
 
+	// Proof jobs of the verified blocks are not needed anymore.
+	if p.proofJobs != nil {
+		if err := p.proofJobs.DeleteUntil(e.BlockId); err != nil {
+			return fmt.Errorf("failed to prune proof jobs: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -951,6 +1028,10 @@ func (p *Prover) onBlockVerified(_ context.Context, e *bindings.TaikoL1ClientBlo
 func (p *Prover) onTransitionProved(ctx context.Context, event *bindings.TaikoL1ClientTransitionProved) error {
 	metrics.ProverReceivedProvenBlockGauge.Update(event.BlockId.Int64())
 
+	if job := p.getProofJob(event.BlockId); job != nil && event.Prover == p.assignedProverAddress {
+		p.setProofJobStatus(event.BlockId, proverDB.ProofJobConfirmed)
+	}
+
 	// If the proof generation is cancellable, cancel it and release the capacity.
 	proofSubmitter := p.getSubmitterByTier(event.Tier)
 	if proofSubmitter != nil && proofSubmitter.Producer().Cancellable() {
@@ -1165,6 +1246,7 @@ func (p *Prover) requestProofByBlockID(
 			minTier = encoding.TierGuardianID
 		}
 		if proofSubmitter := p.selectSubmitter(minTier); proofSubmitter != nil {
+			p.startProofJob(event.BlockId, proofSubmitter.Tier())
 			return proofSubmitter.RequestProof(ctx, event)
 		}
 
@@ -1230,11 +1312,11 @@ func (p *Prover) onProvingWindowExpired(ctx context.Context, e *bindings.TaikoL1
 		"minTier", e.Meta.MinTier,
 	)
 	// If proving window is expired, then the assigned prover can not submit new proofs for it anymore.
-	if p.proverAddress == e.AssignedProver {
+	if p.assignedProverAddress == e.AssignedProver {
 		return nil
 	}
 	// Check if we still need to generate a new proof for that block.
-	proofStatus, err := rpc.GetBlockProofStatus(ctx, p.rpc, e.BlockId, p.proverAddress)
+	proofStatus, err := rpc.GetBlockProofStatus(ctx, p.rpc, e.BlockId, p.assignedProverAddress)
 	if err != nil {
 		return err
 	}
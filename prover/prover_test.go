@@ -82,7 +82,7 @@ func (s *ProverTestSuite) SetupTest() {
 	)
 
 	p.guardianProverSender = guardianproversender.New(
-		p.cfg.L1ProverPrivKey,
+		p.proverSigner,
 		p.cfg.GuardianProverHealthCheckServerEndpoint,
 		memorydb.New(),
 		p.rpc,
@@ -390,7 +390,7 @@ func (s *ProverTestSuite) TestStartSubscription() {
 }
 
 func (s *ProverTestSuite) TestSetApprovalAmount() {
-	opts, err := bind.NewKeyedTransactorWithChainID(s.p.proverPrivateKey, s.p.rpc.L1ChainID)
+	opts, err := bind.NewKeyedTransactorWithChainID(s.p.cfg.L1ProverPrivKey, s.p.rpc.L1ChainID)
 	s.Nil(err)
 
 	tx, err := s.p.rpc.TaikoToken.Approve(opts, s.p.cfg.AssignmentHookAddress, common.Big0)
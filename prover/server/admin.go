@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	proverDB "github.com/taikoxyz/taiko-client/prover/db"
+)
+
+// ProverAdmin is the prover instance which can be inspected and controlled through the admin API.
+type ProverAdmin interface {
+	// ProofJobs returns all in-flight and recent proof jobs.
+	ProofJobs() ([]*proverDB.ProofJob, error)
+	// RequestProof forces the prover to generate a proof for the given block.
+	RequestProof(ctx context.Context, blockID *big.Int, minTier uint16) error
+	// CancelProof cancels the proof generation of the given block.
+	CancelProof(ctx context.Context, blockID *big.Int) error
+}
+
+// ProofJob represents a proof job returned by the admin API.
+type ProofJob struct {
+	BlockID   uint64 `json:"blockID"`
+	Tier      uint16 `json:"tier"`
+	Status    string `json:"status"`
+	CreatedAt uint64 `json:"createdAt"`
+	UpdatedAt uint64 `json:"updatedAt"`
+}
+
+// AdminStatus represents the current prover status returned by the admin API.
+type AdminStatus struct {
+	AssignmentsPaused      bool `json:"assignmentsPaused"`
+	ProposeConcurrency     int  `json:"proposeConcurrency"`
+	OutstandingAssignments int  `json:"outstandingAssignments"`
+	Capacity               int  `json:"capacity"`
+}
+
+// GetAdminStatus handles a query to the current prover status.
+//
+//	@Summary		Get current prover status
+//	@ID			   	get-admin-status
+//	@Produce		json
+//	@Success		200	{object} AdminStatus
+//	@Router			/admin/status [get]
+func (srv *ProverServer) GetAdminStatus(c echo.Context) error {
+	return c.JSON(http.StatusOK, &AdminStatus{
+		AssignmentsPaused:      srv.assignmentsPaused.Load(),
+		ProposeConcurrency:     len(srv.proposeConcurrencyGuard),
+		OutstandingAssignments: srv.OutstandingAssignments(),
+		Capacity:               cap(srv.proposeConcurrencyGuard),
+	})
+}
+
+// GetProofJobs handles a query to the in-flight and recent proof jobs.
+//
+//	@Summary		Get in-flight and recent proof jobs
+//	@ID			   	get-proof-jobs
+//	@Produce		json
+//	@Success		200	{object} []ProofJob
+//	@Failure		422	{string} string "proof jobs are not persisted"
+//	@Router			/admin/jobs [get]
+func (srv *ProverServer) GetProofJobs(c echo.Context) error {
+	jobs, err := srv.admin.ProofJobs()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	res := make([]*ProofJob, 0, len(jobs))
+	for _, job := range jobs {
+		res = append(res, &ProofJob{
+			BlockID:   job.BlockID.Uint64(),
+			Tier:      job.Tier,
+			Status:    string(job.Status),
+			CreatedAt: job.CreatedAt,
+			UpdatedAt: job.UpdatedAt,
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// RequestProof handles a request to force generating a proof for the given block.
+//
+//	@Summary		Force generating a proof for the given block
+//	@Param          blockID     path    int    true    "block ID"
+//	@Param          minTier     query   int    false   "minimum proof tier"
+//	@Success		200
+//	@Failure		400	{string} string "invalid block ID"
+//	@Failure		400	{string} string "invalid minimum tier"
+//	@Router			/admin/jobs/{blockID}/prove [post]
+func (srv *ProverServer) RequestProof(c echo.Context) error {
+	blockID, err := parseBlockID(c)
+	if err != nil {
+		return err
+	}
+
+	var minTier uint64
+	if param := c.QueryParam("minTier"); param != "" {
+		if minTier, err = strconv.ParseUint(param, 10, 16); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid minimum tier")
+		}
+	}
+
+	log.Info("Admin proof request", "blockID", blockID, "minTier", minTier, "ip", c.RealIP())
+
+	if err := srv.admin.RequestProof(c.Request().Context(), blockID, uint16(minTier)); err != nil {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// CancelProof handles a request to cancel the proof generation of the given block.
+//
+//	@Summary		Cancel the proof generation of the given block
+//	@Param          blockID     path    int    true    "block ID"
+//	@Success		200
+//	@Failure		400	{string} string "invalid block ID"
+//	@Router			/admin/jobs/{blockID}/cancel [post]
+func (srv *ProverServer) CancelProof(c echo.Context) error {
+	blockID, err := parseBlockID(c)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Admin proof cancellation", "blockID", blockID, "ip", c.RealIP())
+
+	if err := srv.admin.CancelProof(c.Request().Context(), blockID); err != nil {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// PauseAssignments handles a request to stop accepting new proof assignments.
+//
+//	@Summary		Stop accepting new proof assignments
+//	@Success		200
+//	@Router			/admin/assignments/pause [post]
+func (srv *ProverServer) PauseAssignments(c echo.Context) error {
+	log.Info("Pause accepting proof assignments", "ip", c.RealIP())
+	srv.assignmentsPaused.Store(true)
+
+	return c.NoContent(http.StatusOK)
+}
+
+// ResumeAssignments handles a request to resume accepting new proof assignments.
+//
+//	@Summary		Resume accepting new proof assignments
+//	@Success		200
+//	@Router			/admin/assignments/resume [post]
+func (srv *ProverServer) ResumeAssignments(c echo.Context) error {
+	log.Info("Resume accepting proof assignments", "ip", c.RealIP())
+	srv.assignmentsPaused.Store(false)
+
+	return c.NoContent(http.StatusOK)
+}
+
+// configureAdminRoutes contains all admin API routes, which are only enabled when an admin token is given.
+func (srv *ProverServer) configureAdminRoutes() {
+	if srv.adminToken == "" || srv.admin == nil {
+		return
+	}
+
+	admin := srv.echo.Group("/admin", middleware.KeyAuth(func(key string, _ echo.Context) (bool, error) {
+		return subtle.ConstantTimeCompare([]byte(key), []byte(srv.adminToken)) == 1, nil
+	}))
+	admin.GET("/status", srv.GetAdminStatus)
+	admin.GET("/jobs", srv.GetProofJobs)
+	admin.POST("/jobs/:blockID/prove", srv.RequestProof)
+	admin.POST("/jobs/:blockID/cancel", srv.CancelProof)
+	admin.POST("/assignments/pause", srv.PauseAssignments)
+	admin.POST("/assignments/resume", srv.ResumeAssignments)
+}
+
+// parseBlockID parses the block ID path parameter.
+func parseBlockID(c echo.Context) (*big.Int, error) {
+	blockID, ok := new(big.Int).SetString(c.Param("blockID"), 10)
+	if !ok || blockID.Sign() <= 0 || !blockID.IsUint64() {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid block ID")
+	}
+
+	return blockID, nil
+}
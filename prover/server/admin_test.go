@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	proverDB "github.com/taikoxyz/taiko-client/prover/db"
+)
+
+type testProverAdmin struct {
+	requested map[uint64]uint16
+	cancelled map[uint64]bool
+}
+
+func (a *testProverAdmin) ProofJobs() ([]*proverDB.ProofJob, error) {
+	return []*proverDB.ProofJob{
+		{BlockID: big.NewInt(1), Tier: 200, Status: proverDB.ProofJobGenerating, CreatedAt: 1, UpdatedAt: 2},
+	}, nil
+}
+
+func (a *testProverAdmin) RequestProof(_ context.Context, blockID *big.Int, minTier uint16) error {
+	a.requested[blockID.Uint64()] = minTier
+	return nil
+}
+
+func (a *testProverAdmin) CancelProof(_ context.Context, blockID *big.Int) error {
+	if blockID.Uint64() != 1 {
+		return errors.New("no proof is being generated")
+	}
+	a.cancelled[blockID.Uint64()] = true
+	return nil
+}
+
+func TestAdminAPI(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	admin := &testProverAdmin{requested: map[uint64]uint16{}, cancelled: map[uint64]bool{}}
+	srv, err := New(&NewProverServerOpts{
+		ProverPrivateKey:        key,
+		ProposeConcurrencyGuard: make(chan struct{}, 2),
+		Admin:                   admin,
+		AdminToken:              "token",
+	})
+	require.Nil(t, err)
+
+	send := func(method, path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader("{}"))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		srv.echo.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Unauthenticated requests.
+	require.Equal(t, http.StatusBadRequest, send(http.MethodGet, "/admin/jobs", "").Code)
+	require.Equal(t, http.StatusUnauthorized, send(http.MethodGet, "/admin/jobs", "wrong").Code)
+
+	// Proof jobs.
+	rec := send(http.MethodGet, "/admin/jobs", "token")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var jobs []*ProofJob
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &jobs))
+	require.Equal(t, []*ProofJob{{BlockID: 1, Tier: 200, Status: "generating", CreatedAt: 1, UpdatedAt: 2}}, jobs)
+
+	require.Equal(t, http.StatusOK, send(http.MethodPost, "/admin/jobs/2/prove?minTier=300", "token").Code)
+	require.Equal(t, uint16(300), admin.requested[2])
+	require.Equal(t, http.StatusBadRequest, send(http.MethodPost, "/admin/jobs/0/prove", "token").Code)
+	require.Equal(t, http.StatusBadRequest, send(http.MethodPost, "/admin/jobs/2/prove?minTier=x", "token").Code)
+
+	require.Equal(t, http.StatusOK, send(http.MethodPost, "/admin/jobs/1/cancel", "token").Code)
+	require.True(t, admin.cancelled[1])
+	require.Equal(t, http.StatusUnprocessableEntity, send(http.MethodPost, "/admin/jobs/2/cancel", "token").Code)
+
+	// Pause and resume accepting assignments.
+	require.Equal(t, http.StatusOK, send(http.MethodPost, "/admin/assignments/pause", "token").Code)
+	rec = send(http.MethodPost, "/assignment", "")
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	require.Contains(t, rec.Body.String(), "prover is paused")
+
+	rec = send(http.MethodGet, "/admin/status", "token")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"assignmentsPaused":true`)
+
+	require.Equal(t, http.StatusOK, send(http.MethodPost, "/admin/assignments/resume", "token").Code)
+	require.False(t, srv.assignmentsPaused.Load())
+}
+
+func TestAdminAPIDisabled(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	srv, err := New(&NewProverServerOpts{ProverPrivateKey: key, Admin: &testProverAdmin{}})
+	require.Nil(t, err)
+
+	rec := httptest.NewRecorder()
+	srv.echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/jobs", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -1,15 +1,16 @@
 package server
 
 import (
+	"context"
 	"math/big"
 	"net/http"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/labstack/echo/v4"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	pricefeed "github.com/taikoxyz/taiko-client/pkg/price_feed"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
 )
 
@@ -30,18 +31,22 @@ type CreateAssignmentRequestBody struct {
 	TierFees   []encoding.TierFee
 	Expiry     uint64
 	TxListHash common.Hash
+	// Signature of an unexpired quote returned by the `/quote` API, whose tier fees will be honored.
+	QuoteSignature []byte
 }
 
 // Status represents the current prover server status.
 type Status struct {
-	MinOptimisticTierFee uint64 `json:"minOptimisticTierFee"`
-	MinSgxTierFee        uint64 `json:"minSgxTierFee"`
-	MinPseZkevmTierFee   uint64 `json:"minPseZkevmTierFee"`
-	MaxExpiry            uint64 `json:"maxExpiry"`
-	Prover               string `json:"prover"`
+	MinOptimisticTierFee     *big.Int `json:"minOptimisticTierFee"`
+	MinSgxTierFee            *big.Int `json:"minSgxTierFee"`
+	MinPseZkevmTierFee       *big.Int `json:"minPseZkevmTierFee"`
+	MinSgxAndPseZkevmTierFee *big.Int `json:"minSgxAndPseZkevmTierFee"`
+	MaxExpiry                uint64   `json:"maxExpiry"`
+	Prover                   string   `json:"prover"`
 }
 
-// GetStatus handles a query to the current prover server status.
+// GetStatus handles a query to the current prover server status, the minimum tier fees are the current
+// prices of the pricing engine if enabled.
 //
 //	@Summary		Get current prover server status
 //	@ID			   	get-status
@@ -50,12 +55,19 @@ type Status struct {
 //	@Success		200	{object} Status
 //	@Router			/status [get]
 func (srv *ProverServer) GetStatus(c echo.Context) error {
+	minTierFees, err := srv.minTierFees(c.Request().Context())
+	if err != nil {
+		log.Error("Failed to price tier fees", "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
 	return c.JSON(http.StatusOK, &Status{
-		MinOptimisticTierFee: srv.minOptimisticTierFee.Uint64(),
-		MinSgxTierFee:        srv.minSgxTierFee.Uint64(),
-		MinPseZkevmTierFee:   srv.minPseZkevmTierFee.Uint64(),
-		MaxExpiry:            uint64(srv.maxExpiry.Seconds()),
-		Prover:               srv.proverAddress.Hex(),
+		MinOptimisticTierFee:     minTierFees[encoding.TierOptimisticID],
+		MinSgxTierFee:            minTierFees[encoding.TierSgxID],
+		MinPseZkevmTierFee:       minTierFees[encoding.TierPseZkevmID],
+		MinSgxAndPseZkevmTierFee: minTierFees[encoding.TierSgxAndPseZkevmID],
+		MaxExpiry:                uint64(srv.maxExpiry.Seconds()),
+		Prover:                   srv.proverAddress.Hex(),
 	})
 }
 
@@ -79,10 +91,15 @@ type ProposeBlockResponse struct {
 //	@Success		200		{object} ProposeBlockResponse
 //	@Failure		422		{string} string	"invalid txList hash"
 //	@Failure		422		{string} string	"only receive ETH"
+//	@Failure		422		{string} string	"unsupported fee token"
+//	@Failure		422		{string} string	"unknown tier"
 //	@Failure		422		{string} string	"insufficient prover balance"
 //	@Failure		422		{string} string	"proof fee too low"
 //	@Failure		422		{string} string "expiry too long"
 //	@Failure		422		{string} string "prover does not have capacity"
+//	@Failure		422		{string} string "proposer quota exceeded"
+//	@Failure		422		{string} string "assignment expired"
+//	@Failure		422		{string} string "prover is paused"
 //	@Router			/assignment [post]
 func (srv *ProverServer) CreateAssignment(c echo.Context) error {
 	req := new(CreateAssignmentRequestBody)
@@ -98,11 +115,16 @@ func (srv *ProverServer) CreateAssignment(c echo.Context) error {
 		"txListHash", req.TxListHash,
 	)
 
+	// Check if the prover has been paused through the admin API.
+	if srv.assignmentsPaused.Load() {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, "prover is paused")
+	}
+
 	if req.TxListHash == (common.Hash{}) {
 		return echo.NewHTTPError(http.StatusUnprocessableEntity, "invalid txList hash")
 	}
 
-	if req.FeeToken != (common.Address{}) {
+	if req.FeeToken != (common.Address{}) && srv.feeTokenPriceFeed == nil {
 		return echo.NewHTTPError(http.StatusUnprocessableEntity, "only receive ETH")
 	}
 
@@ -127,23 +149,40 @@ func (srv *ProverServer) CreateAssignment(c echo.Context) error {
 		}
 	}
 
+	minTierFees, err := srv.minTierFees(c.Request().Context())
+	if err != nil {
+		log.Error("Failed to price tier fees", "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
 	for _, tier := range req.TierFees {
 		if tier.Tier == encoding.TierGuardianID {
 			continue
 		}
 
-		var minTierFee *big.Int
-		switch tier.Tier {
-		case encoding.TierOptimisticID:
-			minTierFee = srv.minOptimisticTierFee
-		case encoding.TierSgxID:
-			minTierFee = srv.minSgxTierFee
-		case encoding.TierPseZkevmID:
-			minTierFee = srv.minPseZkevmTierFee
-		case encoding.TierSgxAndPseZkevmID:
-			minTierFee = srv.minSgxAndPseZkevmTierFee
-		default:
+		minTierFee := minTierFees[tier.Tier]
+		if minTierFee == nil {
 			log.Warn("Unknown tier", "tier", tier.Tier, "fee", tier.Fee, "proposerIP", c.RealIP())
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, "unknown tier")
+		}
+
+		// The minimum tier fees are priced in wei, convert them to the fee token's units.
+		if req.FeeToken != (common.Address{}) {
+			if minTierFee, err = pricefeed.WeiToToken(
+				c.Request().Context(),
+				srv.feeTokenPriceFeed,
+				req.FeeToken,
+				minTierFee,
+			); err != nil {
+				log.Warn("Unsupported fee token", "feeToken", req.FeeToken, "error", err, "proposerIP", c.RealIP())
+				return echo.NewHTTPError(http.StatusUnprocessableEntity, "unsupported fee token")
+			}
+		}
+
+		// The tier fee of an unexpired quote signed by this prover is honored, even if the price has risen.
+		if quoted := srv.quotes.tierFee(req.QuoteSignature, req.FeeToken, tier.Tier); quoted != nil &&
+			quoted.Cmp(minTierFee) < 0 {
+			minTierFee = quoted
 		}
 
 		if tier.Fee.Cmp(minTierFee) < 0 {
@@ -168,13 +207,23 @@ func (srv *ProverServer) CreateAssignment(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusUnprocessableEntity, "expiry too long")
 	}
 
-	// Check if the prover has any capacity now.
-	if len(srv.proposeConcurrencyGuard) == cap(srv.proposeConcurrencyGuard) {
-		return echo.NewHTTPError(http.StatusUnprocessableEntity, "prover does not have capacity")
+	// Reserve a proving slot for this assignment until its proposal is seen on chain, or it expires, so that
+	// the prover won't sign more assignments than it can prove.
+	reservation, err := srv.reservations.reserve(
+		req.TxListHash,
+		c.RealIP(),
+		time.Unix(int64(req.Expiry), 0),
+		len(srv.proposeConcurrencyGuard),
+		cap(srv.proposeConcurrencyGuard),
+	)
+	if err != nil {
+		log.Warn("Failed to reserve prover capacity", "txListHash", req.TxListHash, "error", err, "proposerIP", c.RealIP())
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
 	}
 
 	l1Head, err := srv.rpc.L1.BlockNumber(c.Request().Context())
 	if err != nil {
+		srv.reservations.cancel(req.TxListHash, reservation)
 		log.Error("Failed to get L1 block head", "error", err)
 		return echo.NewHTTPError(http.StatusUnprocessableEntity, err)
 	}
@@ -191,12 +240,14 @@ func (srv *ProverServer) CreateAssignment(c echo.Context) error {
 		req.TierFees,
 	)
 	if err != nil {
+		srv.reservations.cancel(req.TxListHash, reservation)
 		log.Error("Failed to encode proverAssignment payload data", "error", err)
 		return echo.NewHTTPError(http.StatusUnprocessableEntity, err)
 	}
 
-	signed, err := crypto.Sign(crypto.Keccak256Hash(encoded).Bytes(), srv.proverPrivateKey)
+	signed, err := srv.proverSigner.SignData(c.Request().Context(), encoded)
 	if err != nil {
+		srv.reservations.cancel(req.TxListHash, reservation)
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
 
@@ -207,3 +258,135 @@ func (srv *ProverServer) CreateAssignment(c echo.Context) error {
 		MaxProposedIn: srv.maxProposedIn,
 	})
 }
+
+// QuoteResponse represents the JSON response which will be returned by the Quote request handler, the
+// signature is signed by the prover signer over the abi-encoded quote payload, so that the proposers can
+// cache the quote until it expires, and send its signature along with the assignment requests to get the
+// quoted tier fees honored.
+type QuoteResponse struct {
+	Prover     common.Address     `json:"prover"`
+	FeeToken   common.Address     `json:"feeToken"`
+	TierFees   []encoding.TierFee `json:"tierFees"`
+	Expiry     uint64             `json:"expiry"`
+	Capacity   uint64             `json:"capacity"`
+	QueueDepth uint64             `json:"queueDepth"`
+	Signature  []byte             `json:"signature"`
+}
+
+// GetQuote handles a query to the current minimum fees of all tiers and the prover capacity.
+//
+//	@Summary		Get current tier fees and prover capacity
+//	@ID			   	get-quote
+//	@Param          feeToken    query   string    false    "fee token address, ETH if not set"
+//	@Produce		json
+//	@Success		200		{object} QuoteResponse
+//	@Failure		422		{string} string	"invalid fee token"
+//	@Failure		422		{string} string	"only receive ETH"
+//	@Failure		422		{string} string	"unsupported fee token"
+//	@Failure		422		{string} string "prover is paused"
+//	@Router			/quote [get]
+func (srv *ProverServer) GetQuote(c echo.Context) error {
+	var feeToken common.Address
+	if param := c.QueryParam("feeToken"); param != "" {
+		if !common.IsHexAddress(param) {
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, "invalid fee token")
+		}
+		feeToken = common.HexToAddress(param)
+	}
+
+	if feeToken != (common.Address{}) && srv.feeTokenPriceFeed == nil {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, "only receive ETH")
+	}
+
+	if srv.assignmentsPaused.Load() {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, "prover is paused")
+	}
+
+	minTierFees, err := srv.minTierFees(c.Request().Context())
+	if err != nil {
+		log.Error("Failed to price tier fees", "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	var tierFees []encoding.TierFee
+	for _, tier := range []uint16{
+		encoding.TierOptimisticID,
+		encoding.TierSgxID,
+		encoding.TierPseZkevmID,
+		encoding.TierSgxAndPseZkevmID,
+	} {
+		fee := minTierFees[tier]
+		if fee == nil {
+			continue
+		}
+
+		if feeToken != (common.Address{}) {
+			if fee, err = pricefeed.WeiToToken(c.Request().Context(), srv.feeTokenPriceFeed, feeToken, fee); err != nil {
+				log.Warn("Unsupported fee token", "feeToken", feeToken, "error", err, "proposerIP", c.RealIP())
+				return echo.NewHTTPError(http.StatusUnprocessableEntity, "unsupported fee token")
+			}
+		}
+
+		tierFees = append(tierFees, encoding.TierFee{Tier: tier, Fee: fee})
+	}
+
+	expiry := uint64(time.Now().Add(quoteTTL).Unix())
+	encoded, err := encoding.EncodeProverQuotePayload(
+		srv.protocolConfigs.ChainId,
+		srv.taikoL1Address,
+		srv.proverAddress,
+		feeToken,
+		expiry,
+		tierFees,
+	)
+	if err != nil {
+		log.Error("Failed to encode prover quote payload data", "error", err)
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err)
+	}
+
+	signed, err := srv.proverSigner.SignData(c.Request().Context(), encoded)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	srv.quotes.add(signed, feeToken, tierFees, time.Unix(int64(expiry), 0))
+
+	return c.JSON(http.StatusOK, &QuoteResponse{
+		Prover:     srv.proverAddress,
+		FeeToken:   feeToken,
+		TierFees:   tierFees,
+		Expiry:     expiry,
+		Capacity:   uint64(cap(srv.proposeConcurrencyGuard)),
+		QueueDepth: uint64(srv.load()),
+		Signature:  signed,
+	})
+}
+
+// minTierFees returns the current minimum fees of all known tiers in wei, which are priced by the pricing
+// engine if enabled, the L1 gas price is only fetched once for all tiers.
+func (srv *ProverServer) minTierFees(ctx context.Context) (map[uint16]*big.Int, error) {
+	minTierFees := make(map[uint16]*big.Int)
+	for tier, fee := range map[uint16]*big.Int{
+		encoding.TierOptimisticID:     srv.minOptimisticTierFee,
+		encoding.TierSgxID:            srv.minSgxTierFee,
+		encoding.TierPseZkevmID:       srv.minPseZkevmTierFee,
+		encoding.TierSgxAndPseZkevmID: srv.minSgxAndPseZkevmTierFee,
+	} {
+		if fee != nil {
+			minTierFees[tier] = fee
+		}
+	}
+
+	if srv.pricingEngine == nil || len(minTierFees) == 0 {
+		return minTierFees, nil
+	}
+
+	gasPrice, err := srv.pricingEngine.GasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for tier, fee := range minTierFees {
+		minTierFees[tier] = srv.pricingEngine.TierFee(tier, fee, gasPrice)
+	}
+
+	return minTierFees, nil
+}
@@ -1,14 +1,23 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"io"
+	"math/big"
 	"net/http"
+	"net/http/httptest"
 	"strings"
+	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	pricefeed "github.com/taikoxyz/taiko-client/pkg/price_feed"
+	"github.com/taikoxyz/taiko-client/prover/pricing"
 )
 
 func (s *ProverServerTestSuite) TestGetStatusSuccess() {
@@ -22,9 +31,9 @@ func (s *ProverServerTestSuite) TestGetStatusSuccess() {
 	s.Nil(err)
 	s.Nil(json.Unmarshal(b, &status))
 
-	s.Equal(s.s.minOptimisticTierFee.Uint64(), status.MinOptimisticTierFee)
-	s.Equal(s.s.minSgxTierFee.Uint64(), status.MinSgxTierFee)
-	s.Equal(s.s.minSgxAndPseZkevmTierFee.Uint64(), status.MinSgxTierFee)
+	s.Equal(s.s.minOptimisticTierFee.Uint64(), status.MinOptimisticTierFee.Uint64())
+	s.Equal(s.s.minSgxTierFee.Uint64(), status.MinSgxTierFee.Uint64())
+	s.Equal(s.s.minSgxAndPseZkevmTierFee.Uint64(), status.MinSgxAndPseZkevmTierFee.Uint64())
 	s.Equal(uint64(s.s.maxExpiry.Seconds()), status.MaxExpiry)
 	s.NotEmpty(status.Prover)
 }
@@ -49,3 +58,172 @@ func (s *ProverServerTestSuite) TestProposeBlockSuccess() {
 	s.Nil(err)
 	s.Contains(string(b), "signedPayload")
 }
+
+func (s *ProverServerTestSuite) TestProposeBlockFeeToken() {
+	var (
+		feeToken = common.BigToAddress(common.Big1)
+		post     = func(token common.Address) *http.Response {
+			data, err := json.Marshal(CreateAssignmentRequestBody{
+				FeeToken:   token,
+				TierFees:   []encoding.TierFee{{Tier: encoding.TierOptimisticID, Fee: common.Big256}},
+				Expiry:     uint64(time.Now().Add(time.Minute).Unix()),
+				TxListHash: common.BigToHash(common.Big1),
+			})
+			s.Nil(err)
+			res, err := http.Post(s.testServer.URL+"/assignment", "application/json", strings.NewReader(string(data)))
+			s.Nil(err)
+			return res
+		}
+	)
+
+	res := post(feeToken)
+	s.Equal(http.StatusUnprocessableEntity, res.StatusCode)
+	s.Nil(res.Body.Close())
+
+	s.s.feeTokenPriceFeed = pricefeed.NewStaticPriceFeed(map[common.Address]*big.Int{feeToken: common.Big1})
+	defer func() { s.s.feeTokenPriceFeed = nil }()
+
+	res = post(common.BigToAddress(common.Big2))
+	s.Equal(http.StatusUnprocessableEntity, res.StatusCode)
+	b, err := io.ReadAll(res.Body)
+	s.Nil(err)
+	s.Nil(res.Body.Close())
+	s.Contains(string(b), "unsupported fee token")
+
+	res = post(feeToken)
+	s.Equal(http.StatusOK, res.StatusCode)
+	s.Nil(res.Body.Close())
+}
+
+type testGasPriceReader struct {
+	gasPrice *big.Int
+	calls    int
+}
+
+func (r *testGasPriceReader) SuggestGasPrice(_ context.Context) (*big.Int, error) {
+	r.calls++
+	return r.gasPrice, nil
+}
+
+func TestGetQuote(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	var (
+		depth    = 0
+		engine   *pricing.Engine
+		gasPrice = &testGasPriceReader{gasPrice: common.Big1}
+	)
+	engine, err = pricing.New(
+		&pricing.Config{Capacity: 2, ProveBlockGas: 100, Margin: 0, MaxSurge: 100},
+		gasPrice,
+		func() int { return depth },
+	)
+	require.Nil(t, err)
+
+	srv, err := New(&NewProverServerOpts{
+		ProverPrivateKey:         key,
+		MinOptimisticTierFee:     big.NewInt(100),
+		MinSgxTierFee:            big.NewInt(200),
+		MinPseZkevmTierFee:       big.NewInt(300),
+		MinSgxAndPseZkevmTierFee: big.NewInt(400),
+		ProposeConcurrencyGuard:  make(chan struct{}, 2),
+		ProtocolConfigs:          &bindings.TaikoDataConfig{ChainId: 167001},
+		PricingEngine:            engine,
+	})
+	require.Nil(t, err)
+
+	getQuote := func(path string) (int, *QuoteResponse) {
+		rec := httptest.NewRecorder()
+		srv.echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		quote := new(QuoteResponse)
+		if rec.Code == http.StatusOK {
+			require.Nil(t, json.Unmarshal(rec.Body.Bytes(), quote))
+		}
+		return rec.Code, quote
+	}
+
+	code, quote := getQuote("/quote")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), quote.Prover)
+	require.Equal(t, uint64(2), quote.Capacity)
+	require.Len(t, quote.TierFees, 4)
+	require.Equal(t, encoding.TierOptimisticID, quote.TierFees[0].Tier)
+	require.Equal(t, big.NewInt(200), quote.TierFees[0].Fee)
+	require.Equal(t, big.NewInt(500), quote.TierFees[3].Fee)
+
+	// The L1 gas price is only fetched once for all tiers.
+	require.Equal(t, 1, gasPrice.calls)
+
+	// The quote is tracked, so that its tier fees will be honored.
+	require.Equal(t, big.NewInt(200), srv.quotes.tierFee(quote.Signature, common.Address{}, encoding.TierOptimisticID))
+
+	// The quote is signed by the prover.
+	payload, err := encoding.EncodeProverQuotePayload(
+		167001,
+		common.Address{},
+		quote.Prover,
+		quote.FeeToken,
+		quote.Expiry,
+		quote.TierFees,
+	)
+	require.Nil(t, err)
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(payload), quote.Signature)
+	require.Nil(t, err)
+	require.Equal(t, quote.Prover, crypto.PubkeyToAddress(*pubKey))
+
+	// The prover is fully loaded.
+	depth = 2
+	code, quote = getQuote("/quote")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, big.NewInt(400), quote.TierFees[0].Fee)
+
+	// The status reports the current prices of the pricing engine.
+	rec := httptest.NewRecorder()
+	srv.echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/status", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	status := new(Status)
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), status))
+	require.Equal(t, big.NewInt(400), status.MinOptimisticTierFee)
+	require.Equal(t, big.NewInt(1000), status.MinSgxAndPseZkevmTierFee)
+
+	code, _ = getQuote("/quote?feeToken=0x")
+	require.Equal(t, http.StatusUnprocessableEntity, code)
+	code, _ = getQuote("/quote?feeToken=" + common.BigToAddress(common.Big1).Hex())
+	require.Equal(t, http.StatusUnprocessableEntity, code)
+}
+
+func TestCreateAssignmentUnknownTier(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	srv, err := New(&NewProverServerOpts{
+		ProverPrivateKey:         key,
+		MinOptimisticTierFee:     common.Big1,
+		MinSgxTierFee:            common.Big1,
+		MinPseZkevmTierFee:       common.Big1,
+		MinSgxAndPseZkevmTierFee: common.Big1,
+		MaxExpiry:                time.Hour,
+		ProposeConcurrencyGuard:  make(chan struct{}, 1),
+		ProtocolConfigs:          &bindings.TaikoDataConfig{ChainId: 167001},
+		IsGuardian:               true,
+	})
+	require.Nil(t, err)
+
+	data, err := json.Marshal(CreateAssignmentRequestBody{
+		TierFees: []encoding.TierFee{
+			{Tier: encoding.TierOptimisticID, Fee: common.Big256},
+			{Tier: 0xff, Fee: common.Big256},
+		},
+		Expiry:     uint64(time.Now().Add(time.Minute).Unix()),
+		TxListHash: common.BigToHash(common.Big1),
+	})
+	require.Nil(t, err)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/assignment", strings.NewReader(string(data)))
+	req.Header.Set("Content-Type", "application/json")
+	srv.echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	require.Contains(t, rec.Body.String(), "unknown tier")
+}
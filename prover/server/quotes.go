@@ -0,0 +1,72 @@
+package server
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+// quote is a quote signed by this prover.
+type quote struct {
+	feeToken common.Address
+	tierFees map[uint16]*big.Int
+	expiry   time.Time
+}
+
+// quotes tracks the unexpired quotes signed by this prover by their signatures, so that the tier fees of a
+// cached quote are honored by the assignment requests until the quote expires.
+type quotes struct {
+	quotes map[common.Hash]*quote
+	mutex  sync.Mutex
+}
+
+// newQuotes creates a new quotes instance.
+func newQuotes() *quotes {
+	return &quotes{quotes: make(map[common.Hash]*quote)}
+}
+
+// add tracks a newly signed quote.
+func (q *quotes) add(signature []byte, feeToken common.Address, tierFees []encoding.TierFee, expiry time.Time) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.prune()
+
+	fees := make(map[uint16]*big.Int, len(tierFees))
+	for _, tierFee := range tierFees {
+		fees[tierFee.Tier] = tierFee.Fee
+	}
+	q.quotes[crypto.Keccak256Hash(signature)] = &quote{feeToken: feeToken, tierFees: fees, expiry: expiry}
+}
+
+// tierFee returns the quoted fee of the given tier and fee token in the quote with the given signature,
+// returns nil if there is no such unexpired quote.
+func (q *quotes) tierFee(signature []byte, feeToken common.Address, tier uint16) *big.Int {
+	if len(signature) == 0 {
+		return nil
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	quote, ok := q.quotes[crypto.Keccak256Hash(signature)]
+	if !ok || quote.feeToken != feeToken || !time.Now().Before(quote.expiry) {
+		return nil
+	}
+
+	return quote.tierFees[tier]
+}
+
+// prune removes all expired quotes.
+func (q *quotes) prune() {
+	now := time.Now()
+	for hash, quote := range q.quotes {
+		if !now.Before(quote.expiry) {
+			delete(q.quotes, hash)
+		}
+	}
+}
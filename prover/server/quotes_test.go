@@ -0,0 +1,35 @@
+package server
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func TestQuotes(t *testing.T) {
+	var (
+		q        = newQuotes()
+		feeToken = common.BigToAddress(common.Big1)
+		expiry   = time.Now().Add(time.Minute)
+	)
+
+	q.add([]byte{1}, feeToken, []encoding.TierFee{{Tier: encoding.TierSgxID, Fee: big.NewInt(100)}}, expiry)
+	q.add([]byte{2}, feeToken, []encoding.TierFee{{Tier: encoding.TierSgxID, Fee: big.NewInt(200)}}, time.Now())
+
+	require.Equal(t, big.NewInt(100), q.tierFee([]byte{1}, feeToken, encoding.TierSgxID))
+
+	// Unknown tier, fee token or signature.
+	require.Nil(t, q.tierFee([]byte{1}, feeToken, encoding.TierOptimisticID))
+	require.Nil(t, q.tierFee([]byte{1}, common.Address{}, encoding.TierSgxID))
+	require.Nil(t, q.tierFee([]byte{3}, feeToken, encoding.TierSgxID))
+	require.Nil(t, q.tierFee(nil, feeToken, encoding.TierSgxID))
+
+	// Expired quote.
+	require.Nil(t, q.tierFee([]byte{2}, feeToken, encoding.TierSgxID))
+	q.add([]byte{3}, feeToken, nil, time.Now().Add(time.Minute))
+	require.Len(t, q.quotes, 2)
+}
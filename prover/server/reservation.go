@@ -0,0 +1,154 @@
+package server
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	errNoCapacity         = errors.New("prover does not have capacity")
+	errProposerQuotaUsed  = errors.New("proposer quota exceeded")
+	errReservationExpired = errors.New("assignment expired")
+)
+
+// reservation is a capacity reservation of a signed assignment.
+type reservation struct {
+	proposer string
+	expiry   time.Time
+}
+
+// reservations tracks the outstanding signed assignments by their txList hashes, each of them reserves
+// a proving slot until its proposal is seen on chain, or it expires. Several assignments may be signed
+// for the same txList hash, e.g. the proposals reusing a shared blob, so each of them is counted.
+type reservations struct {
+	// Maximum number of outstanding assignments for a single proposer, zero means no limit.
+	proposerQuota uint64
+	reservations  map[common.Hash][]*reservation
+	mutex         sync.Mutex
+}
+
+// newReservations creates a new reservations instance.
+func newReservations(proposerQuota uint64) *reservations {
+	return &reservations{proposerQuota: proposerQuota, reservations: make(map[common.Hash][]*reservation)}
+}
+
+// reserve reserves a proving slot for the given assignment, the given number of slots are already in use.
+func (r *reservations) reserve(
+	txListHash common.Hash,
+	proposer string,
+	expiry time.Time,
+	used int,
+	capacity int,
+) (*reservation, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.prune()
+
+	if !time.Now().Before(expiry) {
+		return nil, errReservationExpired
+	}
+
+	var reserved, proposerReserved int
+	for _, reservations := range r.reservations {
+		for _, reservation := range reservations {
+			reserved++
+			if reservation.proposer == proposer {
+				proposerReserved++
+			}
+		}
+	}
+	if r.proposerQuota != 0 && uint64(proposerReserved) >= r.proposerQuota {
+		return nil, errProposerQuotaUsed
+	}
+	if used+reserved >= capacity {
+		return nil, errNoCapacity
+	}
+
+	res := &reservation{proposer: proposer, expiry: expiry}
+	r.reservations[txListHash] = append(r.reservations[txListHash], res)
+
+	return res, nil
+}
+
+// release releases a reservation of the given txList hash, the one which expires first is released.
+func (r *reservations) release(txListHash common.Hash) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	reservations := r.reservations[txListHash]
+	if len(reservations) == 0 {
+		return false
+	}
+
+	first := reservations[0]
+	for _, reservation := range reservations[1:] {
+		if reservation.expiry.Before(first.expiry) {
+			first = reservation
+		}
+	}
+	r.remove(txListHash, first)
+
+	return true
+}
+
+// cancel cancels the given reservation of the given txList hash, when its assignment fails to be signed.
+func (r *reservations) cancel(txListHash common.Hash, res *reservation) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.remove(txListHash, res)
+}
+
+// count returns the number of the outstanding reservations.
+func (r *reservations) count() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.prune()
+
+	var count int
+	for _, reservations := range r.reservations {
+		count += len(reservations)
+	}
+
+	return count
+}
+
+// remove removes the given reservation of the given txList hash.
+func (r *reservations) remove(txListHash common.Hash, res *reservation) {
+	var remaining []*reservation
+	for _, reservation := range r.reservations[txListHash] {
+		if reservation != res {
+			remaining = append(remaining, reservation)
+		}
+	}
+
+	if len(remaining) == 0 {
+		delete(r.reservations, txListHash)
+	} else {
+		r.reservations[txListHash] = remaining
+	}
+}
+
+// prune removes all expired reservations.
+func (r *reservations) prune() {
+	now := time.Now()
+	for hash, reservations := range r.reservations {
+		var remaining []*reservation
+		for _, reservation := range reservations {
+			if now.Before(reservation.expiry) {
+				remaining = append(remaining, reservation)
+			}
+		}
+
+		if len(remaining) == 0 {
+			delete(r.reservations, hash)
+		} else {
+			r.reservations[hash] = remaining
+		}
+	}
+}
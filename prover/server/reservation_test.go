@@ -0,0 +1,79 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservations(t *testing.T) {
+	var (
+		r      = newReservations(2)
+		expiry = time.Now().Add(time.Minute)
+	)
+
+	// Reservations count against the capacity.
+	_, err := r.reserve(common.Hash{1}, "a", expiry, 1, 4)
+	require.Nil(t, err)
+	_, err = r.reserve(common.Hash{2}, "b", expiry, 1, 4)
+	require.Nil(t, err)
+	_, err = r.reserve(common.Hash{3}, "c", expiry, 2, 4)
+	require.ErrorIs(t, err, errNoCapacity)
+
+	// Per proposer quota.
+	_, err = r.reserve(common.Hash{3}, "a", expiry, 0, 8)
+	require.Nil(t, err)
+	_, err = r.reserve(common.Hash{4}, "a", expiry, 0, 8)
+	require.ErrorIs(t, err, errProposerQuotaUsed)
+
+	// Proposals seen on chain release the reservations.
+	require.True(t, r.release(common.Hash{1}))
+	require.False(t, r.release(common.Hash{1}))
+	_, err = r.reserve(common.Hash{4}, "a", expiry, 0, 8)
+	require.Nil(t, err)
+
+	_, err = r.reserve(common.Hash{5}, "c", time.Now(), 0, 8)
+	require.ErrorIs(t, err, errReservationExpired)
+}
+
+func TestReservationsSameTxListHash(t *testing.T) {
+	var (
+		r      = newReservations(0)
+		expiry = time.Now().Add(time.Minute)
+	)
+
+	// The assignments of the proposals reusing a shared blob are counted separately.
+	first, err := r.reserve(common.Hash{1}, "a", expiry, 0, 3)
+	require.Nil(t, err)
+	_, err = r.reserve(common.Hash{1}, "a", expiry.Add(time.Second), 0, 3)
+	require.Nil(t, err)
+	_, err = r.reserve(common.Hash{1}, "a", expiry, 1, 3)
+	require.ErrorIs(t, err, errNoCapacity)
+	require.Equal(t, 2, r.count())
+
+	// A proposal seen on chain only releases one of them.
+	require.True(t, r.release(common.Hash{1}))
+	require.Equal(t, 1, r.count())
+
+	// Cancelling a released reservation is a no-op.
+	r.cancel(common.Hash{1}, first)
+	require.Equal(t, 1, r.count())
+	require.True(t, r.release(common.Hash{1}))
+	require.Zero(t, r.count())
+}
+
+func TestReservationsExpiry(t *testing.T) {
+	r := newReservations(0)
+
+	_, err := r.reserve(common.Hash{1}, "a", time.Now().Add(10*time.Millisecond), 0, 1)
+	require.Nil(t, err)
+	_, err = r.reserve(common.Hash{2}, "a", time.Now().Add(time.Minute), 0, 1)
+	require.ErrorIs(t, err, errNoCapacity)
+
+	time.Sleep(20 * time.Millisecond)
+	require.Zero(t, r.count())
+	_, err = r.reserve(common.Hash{2}, "a", time.Now().Add(time.Minute), 0, 1)
+	require.Nil(t, err)
+}
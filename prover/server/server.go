@@ -6,17 +6,24 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
 	echo "github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/taikoxyz/taiko-client/bindings"
+	pricefeed "github.com/taikoxyz/taiko-client/pkg/price_feed"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+	"github.com/taikoxyz/taiko-client/prover/pricing"
 )
 
+// Quotes returned by the `/quote` API are valid for this duration.
+var quoteTTL = 1 * time.Minute
+
 // @title Taiko Prover API
 // @version 1.0
 // @termsOfService http://swagger.io/terms/
@@ -32,7 +39,8 @@ import (
 // ProverServer represents a prover server instance.
 type ProverServer struct {
 	echo                     *echo.Echo
-	proverPrivateKey         *ecdsa.PrivateKey
+	proverSigner             signer.Signer
+	feeTokenPriceFeed        pricefeed.PriceFeed
 	proverAddress            common.Address
 	minOptimisticTierFee     *big.Int
 	minSgxTierFee            *big.Int
@@ -49,11 +57,20 @@ type ProverServer struct {
 	livenessBond             *big.Int
 	isGuardian               bool
 	db                       ethdb.KeyValueStore
+	admin                    ProverAdmin
+	adminToken               string
+	assignmentsPaused        atomic.Bool
+	pricingEngine            *pricing.Engine
+	reservations             *reservations
+	quotes                   *quotes
 }
 
 // NewProverServerOpts contains all configurations for creating a prover server instance.
 type NewProverServerOpts struct {
 	ProverPrivateKey         *ecdsa.PrivateKey
+	ProverSigner             signer.Signer
+	FeeTokenPriceFeed        pricefeed.PriceFeed
+	ContractProverAddress    common.Address
 	MinOptimisticTierFee     *big.Int
 	MinSgxTierFee            *big.Int
 	MinPseZkevmTierFee       *big.Int
@@ -69,13 +86,30 @@ type NewProverServerOpts struct {
 	LivenessBond             *big.Int
 	IsGuardian               bool
 	DB                       ethdb.KeyValueStore
+	Admin                    ProverAdmin
+	AdminToken               string
+	PricingEngine            *pricing.Engine
+	ProposerQuota            uint64
 }
 
 // New creates a new prover server instance.
 func New(opts *NewProverServerOpts) (*ProverServer, error) {
+	proverSigner := opts.ProverSigner
+	if proverSigner == nil {
+		proverSigner = signer.NewLocalSigner(opts.ProverPrivateKey)
+	}
+
+	// A smart contract prover validates the signatures of the prover signer through EIP-1271, so the
+	// assignments are signed on behalf of the contract.
+	proverAddress := proverSigner.Address()
+	if opts.ContractProverAddress != (common.Address{}) {
+		proverAddress = opts.ContractProverAddress
+	}
+
 	srv := &ProverServer{
-		proverPrivateKey:         opts.ProverPrivateKey,
-		proverAddress:            crypto.PubkeyToAddress(opts.ProverPrivateKey.PublicKey),
+		proverSigner:             proverSigner,
+		feeTokenPriceFeed:        opts.FeeTokenPriceFeed,
+		proverAddress:            proverAddress,
 		echo:                     echo.New(),
 		minOptimisticTierFee:     opts.MinOptimisticTierFee,
 		minSgxTierFee:            opts.MinSgxTierFee,
@@ -92,9 +126,16 @@ func New(opts *NewProverServerOpts) (*ProverServer, error) {
 		livenessBond:             opts.LivenessBond,
 		isGuardian:               opts.IsGuardian,
 		db:                       opts.DB,
+		admin:                    opts.Admin,
+		adminToken:               opts.AdminToken,
+		pricingEngine:            opts.PricingEngine,
+		reservations:             newReservations(opts.ProposerQuota),
+		quotes:                   newQuotes(),
 	}
 
 	srv.echo.HideBanner = true
+	// The proposer quota is enforced by the client IP, so the client-supplied headers can't be trusted.
+	srv.echo.IPExtractor = echo.ExtractIPDirect()
 	srv.configureMiddleware()
 	srv.configureRoutes()
 
@@ -111,6 +152,26 @@ func (srv *ProverServer) Shutdown(ctx context.Context) error {
 	return srv.echo.Shutdown(ctx)
 }
 
+// OutstandingAssignments returns the number of the signed assignments whose proposals have not been
+// seen on chain yet, and have not expired.
+func (srv *ProverServer) OutstandingAssignments() int {
+	return srv.reservations.count()
+}
+
+// OnBlockProposed releases the capacity reserved by an assignment of the given txList hash, since its
+// proposal has been seen on chain.
+func (srv *ProverServer) OnBlockProposed(txListHash common.Hash) {
+	if srv.reservations.release(txListHash) {
+		log.Debug("Assignment reservation released", "txListHash", txListHash)
+	}
+}
+
+// load returns the number of the proving slots in use, including the ones reserved by the outstanding
+// assignments.
+func (srv *ProverServer) load() int {
+	return len(srv.proposeConcurrencyGuard) + srv.OutstandingAssignments()
+}
+
 // Health endpoints for probes.
 func (srv *ProverServer) Health(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
@@ -146,4 +207,6 @@ func (srv *ProverServer) configureRoutes() {
 	srv.echo.GET("/healthz", srv.Health)
 	srv.echo.GET("/status", srv.GetStatus)
 	srv.echo.POST("/assignment", srv.CreateAssignment)
+	srv.echo.GET("/quote", srv.GetQuote)
+	srv.configureAdminRoutes()
 }